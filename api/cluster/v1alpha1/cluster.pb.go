@@ -36,7 +36,7 @@ var file_api_cluster_v1alpha1_cluster_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe4, 0x0d, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa7, 0x2e, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
 	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x04, 0x50, 0x69, 0x6e,
 	0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
@@ -146,30 +146,325 @@ var file_api_cluster_v1alpha1_cluster_proto_rawDesc = []byte{
 	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x67,
 	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x3b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x27, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x2f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0x82, 0x01, 0x0a, 0x11, 0x53, 0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2a, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x65,
+	0x70, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x6c, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x21,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x70, 0x6c, 0x61, 0x6e, 0x12, 0x6d, 0x0a, 0x0e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x75, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x74, 0x63, 0x64, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x74, 0x63, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x65, 0x74, 0x63, 0x64, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x14,
+	0x53, 0x61, 0x76, 0x65, 0x45, 0x74, 0x63, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x74, 0x63, 0x64,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x65,
+	0x74, 0x63, 0x64, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x63, 0x64, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x74,
+	0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x74,
+	0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1e,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x35,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2f, 0x65, 0x74, 0x63, 0x64, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x7e, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x22, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x65, 0x74, 0x63, 0x64, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x71, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x4b, 0x69, 0x6e,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6b, 0x69,
+	0x6e, 0x64, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x78, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a,
+	0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x2f, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x12, 0x9b, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x67, 0x63, 0x12, 0x79, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x79, 0x0a,
+	0x0d, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x69, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x82, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x65, 0x0a, 0x0a, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
+	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x65, 0x0a, 0x0a, 0x52,
+	0x65, 0x69, 0x6e, 0x69, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x73, 0x67, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x69, 0x6e,
+	0x69, 0x74, 0x12, 0x67, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x09, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x73, 0x67, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x12, 0x83, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x70, 0x65, 0x63, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x21, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x46, 0x69,
+	0x6c, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x2e, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x6e, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x84, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x6b, 0x65, 0x79, 0x2f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x75, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7d, 0x0a,
+	0x0d, 0x53, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7e, 0x0a, 0x0c,
+	0x52, 0x75, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x2e, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x70, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x85, 0x01, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x78, 0x0a, 0x12, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
+	0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x1f,
+	0x5a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_api_cluster_v1alpha1_cluster_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),     // 0: google.protobuf.Empty
-	(*ClusterIdArgs)(nil),     // 1: cluster.v1alpha1.ClusterIdArgs
-	(*ClusterIdsArgs)(nil),    // 2: cluster.v1alpha1.ClusterIdsArgs
-	(*ClusterSaveArgs)(nil),   // 3: cluster.v1alpha1.ClusterSaveArgs
-	(*ClusterListArgs)(nil),   // 4: cluster.v1alpha1.ClusterListArgs
-	(*ClusterRegionArgs)(nil), // 5: cluster.v1alpha1.ClusterRegionArgs
-	(*common.Msg)(nil),        // 6: common.Msg
-	(*ClusterProviders)(nil),  // 7: cluster.v1alpha1.ClusterProviders
-	(*ClusterStatuses)(nil),   // 8: cluster.v1alpha1.ClusterStatuses
-	(*ClusterLevels)(nil),     // 9: cluster.v1alpha1.ClusterLevels
-	(*NodeRoles)(nil),         // 10: cluster.v1alpha1.NodeRoles
-	(*NodeStatuses)(nil),      // 11: cluster.v1alpha1.NodeStatuses
-	(*NodeGroupTypes)(nil),    // 12: cluster.v1alpha1.NodeGroupTypes
-	(*ResourceTypes)(nil),     // 13: cluster.v1alpha1.ResourceTypes
-	(*Cluster)(nil),           // 14: cluster.v1alpha1.Cluster
-	(*ClusterList)(nil),       // 15: cluster.v1alpha1.ClusterList
-	(*Regions)(nil),           // 16: cluster.v1alpha1.Regions
+	(*emptypb.Empty)(nil),             // 0: google.protobuf.Empty
+	(*ClusterIdArgs)(nil),             // 1: cluster.v1alpha1.ClusterIdArgs
+	(*ClusterIdsArgs)(nil),            // 2: cluster.v1alpha1.ClusterIdsArgs
+	(*ClusterSaveArgs)(nil),           // 3: cluster.v1alpha1.ClusterSaveArgs
+	(*ClusterListArgs)(nil),           // 4: cluster.v1alpha1.ClusterListArgs
+	(*ClusterRegionArgs)(nil),         // 5: cluster.v1alpha1.ClusterRegionArgs
+	(*ClusterEventListArgs)(nil),      // 6: cluster.v1alpha1.ClusterEventListArgs
+	(*ClusterProvisionStepArgs)(nil),  // 7: cluster.v1alpha1.ClusterProvisionStepArgs
+	(*ClusterPlanArgs)(nil),           // 8: cluster.v1alpha1.ClusterPlanArgs
+	(*ClusterUpgradeArgs)(nil),        // 9: cluster.v1alpha1.ClusterUpgradeArgs
+	(*EtcdBackupPolicy)(nil),          // 10: cluster.v1alpha1.EtcdBackupPolicy
+	(*EtcdSnapshotArgs)(nil),          // 11: cluster.v1alpha1.EtcdSnapshotArgs
+	(*ClusterLoadImageArgs)(nil),      // 12: cluster.v1alpha1.ClusterLoadImageArgs
+	(*ClusterImportArgs)(nil),         // 13: cluster.v1alpha1.ClusterImportArgs
+	(*CloudDriftReportArgs)(nil),      // 14: cluster.v1alpha1.CloudDriftReportArgs
+	(*OrphanedCloudResourceArgs)(nil), // 15: cluster.v1alpha1.OrphanedCloudResourceArgs
+	(*NodeGroupArgs)(nil),             // 16: cluster.v1alpha1.NodeGroupArgs
+	(*NodeGroupIdArgs)(nil),           // 17: cluster.v1alpha1.NodeGroupIdArgs
+	(*NodeListArgs)(nil),              // 18: cluster.v1alpha1.NodeListArgs
+	(*NodeIdArgs)(nil),                // 19: cluster.v1alpha1.NodeIdArgs
+	(*ClusterSpecExportArgs)(nil),     // 20: cluster.v1alpha1.ClusterSpecExportArgs
+	(*ClusterSpecApplyArgs)(nil),      // 21: cluster.v1alpha1.ClusterSpecApplyArgs
+	(*NodeHostKeyArgs)(nil),           // 22: cluster.v1alpha1.NodeHostKeyArgs
+	(*InventorySaveArgs)(nil),         // 23: cluster.v1alpha1.InventorySaveArgs
+	(*CertificateListArgs)(nil),       // 24: cluster.v1alpha1.CertificateListArgs
+	(*common.Msg)(nil),                // 25: common.Msg
+	(*ClusterProviders)(nil),          // 26: cluster.v1alpha1.ClusterProviders
+	(*ClusterStatuses)(nil),           // 27: cluster.v1alpha1.ClusterStatuses
+	(*ClusterLevels)(nil),             // 28: cluster.v1alpha1.ClusterLevels
+	(*NodeRoles)(nil),                 // 29: cluster.v1alpha1.NodeRoles
+	(*NodeStatuses)(nil),              // 30: cluster.v1alpha1.NodeStatuses
+	(*NodeGroupTypes)(nil),            // 31: cluster.v1alpha1.NodeGroupTypes
+	(*ResourceTypes)(nil),             // 32: cluster.v1alpha1.ResourceTypes
+	(*Cluster)(nil),                   // 33: cluster.v1alpha1.Cluster
+	(*ClusterList)(nil),               // 34: cluster.v1alpha1.ClusterList
+	(*Regions)(nil),                   // 35: cluster.v1alpha1.Regions
+	(*ClusterEventList)(nil),          // 36: cluster.v1alpha1.ClusterEventList
+	(*ClusterProvisionSteps)(nil),     // 37: cluster.v1alpha1.ClusterProvisionSteps
+	(*ClusterPlan)(nil),               // 38: cluster.v1alpha1.ClusterPlan
+	(*EtcdSnapshotList)(nil),          // 39: cluster.v1alpha1.EtcdSnapshotList
+	(*EtcdSnapshot)(nil),              // 40: cluster.v1alpha1.EtcdSnapshot
+	(*CloudDriftReport)(nil),          // 41: cluster.v1alpha1.CloudDriftReport
+	(*OrphanedCloudResources)(nil),    // 42: cluster.v1alpha1.OrphanedCloudResources
+	(*NodeGroups)(nil),                // 43: cluster.v1alpha1.NodeGroups
+	(*NodeGroup)(nil),                 // 44: cluster.v1alpha1.NodeGroup
+	(*Nodes)(nil),                     // 45: cluster.v1alpha1.Nodes
+	(*NodeSystemInfo)(nil),            // 46: cluster.v1alpha1.NodeSystemInfo
+	(*ClusterSpecFile)(nil),           // 47: cluster.v1alpha1.ClusterSpecFile
+	(*Node)(nil),                      // 48: cluster.v1alpha1.Node
+	(*Inventory)(nil),                 // 49: cluster.v1alpha1.Inventory
+	(*PreflightReport)(nil),           // 50: cluster.v1alpha1.PreflightReport
+	(*Certificates)(nil),              // 51: cluster.v1alpha1.Certificates
 }
 var file_api_cluster_v1alpha1_cluster_proto_depIdxs = []int32{
 	0,  // 0: cluster.v1alpha1.ClusterInterface.Ping:input_type -> google.protobuf.Empty
@@ -188,24 +483,90 @@ var file_api_cluster_v1alpha1_cluster_proto_depIdxs = []int32{
 	1,  // 13: cluster.v1alpha1.ClusterInterface.Start:input_type -> cluster.v1alpha1.ClusterIdArgs
 	1,  // 14: cluster.v1alpha1.ClusterInterface.Stop:input_type -> cluster.v1alpha1.ClusterIdArgs
 	5,  // 15: cluster.v1alpha1.ClusterInterface.GetRegions:input_type -> cluster.v1alpha1.ClusterRegionArgs
-	6,  // 16: cluster.v1alpha1.ClusterInterface.Ping:output_type -> common.Msg
-	7,  // 17: cluster.v1alpha1.ClusterInterface.GetClusterProviders:output_type -> cluster.v1alpha1.ClusterProviders
-	8,  // 18: cluster.v1alpha1.ClusterInterface.GetClusterStatuses:output_type -> cluster.v1alpha1.ClusterStatuses
-	9,  // 19: cluster.v1alpha1.ClusterInterface.GetClusterLevels:output_type -> cluster.v1alpha1.ClusterLevels
-	10, // 20: cluster.v1alpha1.ClusterInterface.GetNodeRoles:output_type -> cluster.v1alpha1.NodeRoles
-	11, // 21: cluster.v1alpha1.ClusterInterface.GetNodeStatuses:output_type -> cluster.v1alpha1.NodeStatuses
-	12, // 22: cluster.v1alpha1.ClusterInterface.GetNodeGroupTypes:output_type -> cluster.v1alpha1.NodeGroupTypes
-	13, // 23: cluster.v1alpha1.ClusterInterface.GetResourceTypes:output_type -> cluster.v1alpha1.ResourceTypes
-	14, // 24: cluster.v1alpha1.ClusterInterface.Get:output_type -> cluster.v1alpha1.Cluster
-	15, // 25: cluster.v1alpha1.ClusterInterface.GetClustersByIds:output_type -> cluster.v1alpha1.ClusterList
-	14, // 26: cluster.v1alpha1.ClusterInterface.Save:output_type -> cluster.v1alpha1.Cluster
-	15, // 27: cluster.v1alpha1.ClusterInterface.List:output_type -> cluster.v1alpha1.ClusterList
-	6,  // 28: cluster.v1alpha1.ClusterInterface.Delete:output_type -> common.Msg
-	6,  // 29: cluster.v1alpha1.ClusterInterface.Start:output_type -> common.Msg
-	6,  // 30: cluster.v1alpha1.ClusterInterface.Stop:output_type -> common.Msg
-	16, // 31: cluster.v1alpha1.ClusterInterface.GetRegions:output_type -> cluster.v1alpha1.Regions
-	16, // [16:32] is the sub-list for method output_type
-	0,  // [0:16] is the sub-list for method input_type
+	6,  // 16: cluster.v1alpha1.ClusterInterface.ListEvents:input_type -> cluster.v1alpha1.ClusterEventListArgs
+	1,  // 17: cluster.v1alpha1.ClusterInterface.GetProvisionSteps:input_type -> cluster.v1alpha1.ClusterIdArgs
+	7,  // 18: cluster.v1alpha1.ClusterInterface.RetryProvisionStep:input_type -> cluster.v1alpha1.ClusterProvisionStepArgs
+	7,  // 19: cluster.v1alpha1.ClusterInterface.SkipProvisionStep:input_type -> cluster.v1alpha1.ClusterProvisionStepArgs
+	8,  // 20: cluster.v1alpha1.ClusterInterface.Plan:input_type -> cluster.v1alpha1.ClusterPlanArgs
+	9,  // 21: cluster.v1alpha1.ClusterInterface.UpgradeCluster:input_type -> cluster.v1alpha1.ClusterUpgradeArgs
+	1,  // 22: cluster.v1alpha1.ClusterInterface.GetEtcdBackupPolicy:input_type -> cluster.v1alpha1.ClusterIdArgs
+	10, // 23: cluster.v1alpha1.ClusterInterface.SaveEtcdBackupPolicy:input_type -> cluster.v1alpha1.EtcdBackupPolicy
+	1,  // 24: cluster.v1alpha1.ClusterInterface.ListEtcdSnapshots:input_type -> cluster.v1alpha1.ClusterIdArgs
+	11, // 25: cluster.v1alpha1.ClusterInterface.VerifyEtcdSnapshot:input_type -> cluster.v1alpha1.EtcdSnapshotArgs
+	11, // 26: cluster.v1alpha1.ClusterInterface.RestoreEtcdSnapshot:input_type -> cluster.v1alpha1.EtcdSnapshotArgs
+	12, // 27: cluster.v1alpha1.ClusterInterface.LoadKindImage:input_type -> cluster.v1alpha1.ClusterLoadImageArgs
+	13, // 28: cluster.v1alpha1.ClusterInterface.ImportCluster:input_type -> cluster.v1alpha1.ClusterImportArgs
+	14, // 29: cluster.v1alpha1.ClusterInterface.GetCloudDriftReport:input_type -> cluster.v1alpha1.CloudDriftReportArgs
+	1,  // 30: cluster.v1alpha1.ClusterInterface.RepairCloudDrift:input_type -> cluster.v1alpha1.ClusterIdArgs
+	15, // 31: cluster.v1alpha1.ClusterInterface.CollectOrphanedCloudResources:input_type -> cluster.v1alpha1.OrphanedCloudResourceArgs
+	1,  // 32: cluster.v1alpha1.ClusterInterface.ListNodeGroups:input_type -> cluster.v1alpha1.ClusterIdArgs
+	16, // 33: cluster.v1alpha1.ClusterInterface.SaveNodeGroup:input_type -> cluster.v1alpha1.NodeGroupArgs
+	17, // 34: cluster.v1alpha1.ClusterInterface.DeleteNodeGroup:input_type -> cluster.v1alpha1.NodeGroupIdArgs
+	18, // 35: cluster.v1alpha1.ClusterInterface.ListNodes:input_type -> cluster.v1alpha1.NodeListArgs
+	19, // 36: cluster.v1alpha1.ClusterInterface.GetNodeSystemInfo:input_type -> cluster.v1alpha1.NodeIdArgs
+	19, // 37: cluster.v1alpha1.ClusterInterface.RebootNode:input_type -> cluster.v1alpha1.NodeIdArgs
+	19, // 38: cluster.v1alpha1.ClusterInterface.ReinitNode:input_type -> cluster.v1alpha1.NodeIdArgs
+	19, // 39: cluster.v1alpha1.ClusterInterface.ReplaceNode:input_type -> cluster.v1alpha1.NodeIdArgs
+	19, // 40: cluster.v1alpha1.ClusterInterface.RetryNode:input_type -> cluster.v1alpha1.NodeIdArgs
+	20, // 41: cluster.v1alpha1.ClusterInterface.ExportClusterSpec:input_type -> cluster.v1alpha1.ClusterSpecExportArgs
+	21, // 42: cluster.v1alpha1.ClusterInterface.ApplyClusterSpec:input_type -> cluster.v1alpha1.ClusterSpecApplyArgs
+	22, // 43: cluster.v1alpha1.ClusterInterface.AcceptNodeHostKey:input_type -> cluster.v1alpha1.NodeHostKeyArgs
+	1,  // 44: cluster.v1alpha1.ClusterInterface.GetInventory:input_type -> cluster.v1alpha1.ClusterIdArgs
+	23, // 45: cluster.v1alpha1.ClusterInterface.SaveInventory:input_type -> cluster.v1alpha1.InventorySaveArgs
+	1,  // 46: cluster.v1alpha1.ClusterInterface.RunPreflight:input_type -> cluster.v1alpha1.ClusterIdArgs
+	24, // 47: cluster.v1alpha1.ClusterInterface.ListCertificates:input_type -> cluster.v1alpha1.CertificateListArgs
+	1,  // 48: cluster.v1alpha1.ClusterInterface.RotateCertificates:input_type -> cluster.v1alpha1.ClusterIdArgs
+	25, // 49: cluster.v1alpha1.ClusterInterface.Ping:output_type -> common.Msg
+	26, // 50: cluster.v1alpha1.ClusterInterface.GetClusterProviders:output_type -> cluster.v1alpha1.ClusterProviders
+	27, // 51: cluster.v1alpha1.ClusterInterface.GetClusterStatuses:output_type -> cluster.v1alpha1.ClusterStatuses
+	28, // 52: cluster.v1alpha1.ClusterInterface.GetClusterLevels:output_type -> cluster.v1alpha1.ClusterLevels
+	29, // 53: cluster.v1alpha1.ClusterInterface.GetNodeRoles:output_type -> cluster.v1alpha1.NodeRoles
+	30, // 54: cluster.v1alpha1.ClusterInterface.GetNodeStatuses:output_type -> cluster.v1alpha1.NodeStatuses
+	31, // 55: cluster.v1alpha1.ClusterInterface.GetNodeGroupTypes:output_type -> cluster.v1alpha1.NodeGroupTypes
+	32, // 56: cluster.v1alpha1.ClusterInterface.GetResourceTypes:output_type -> cluster.v1alpha1.ResourceTypes
+	33, // 57: cluster.v1alpha1.ClusterInterface.Get:output_type -> cluster.v1alpha1.Cluster
+	34, // 58: cluster.v1alpha1.ClusterInterface.GetClustersByIds:output_type -> cluster.v1alpha1.ClusterList
+	33, // 59: cluster.v1alpha1.ClusterInterface.Save:output_type -> cluster.v1alpha1.Cluster
+	34, // 60: cluster.v1alpha1.ClusterInterface.List:output_type -> cluster.v1alpha1.ClusterList
+	25, // 61: cluster.v1alpha1.ClusterInterface.Delete:output_type -> common.Msg
+	25, // 62: cluster.v1alpha1.ClusterInterface.Start:output_type -> common.Msg
+	25, // 63: cluster.v1alpha1.ClusterInterface.Stop:output_type -> common.Msg
+	35, // 64: cluster.v1alpha1.ClusterInterface.GetRegions:output_type -> cluster.v1alpha1.Regions
+	36, // 65: cluster.v1alpha1.ClusterInterface.ListEvents:output_type -> cluster.v1alpha1.ClusterEventList
+	37, // 66: cluster.v1alpha1.ClusterInterface.GetProvisionSteps:output_type -> cluster.v1alpha1.ClusterProvisionSteps
+	25, // 67: cluster.v1alpha1.ClusterInterface.RetryProvisionStep:output_type -> common.Msg
+	25, // 68: cluster.v1alpha1.ClusterInterface.SkipProvisionStep:output_type -> common.Msg
+	38, // 69: cluster.v1alpha1.ClusterInterface.Plan:output_type -> cluster.v1alpha1.ClusterPlan
+	25, // 70: cluster.v1alpha1.ClusterInterface.UpgradeCluster:output_type -> common.Msg
+	10, // 71: cluster.v1alpha1.ClusterInterface.GetEtcdBackupPolicy:output_type -> cluster.v1alpha1.EtcdBackupPolicy
+	10, // 72: cluster.v1alpha1.ClusterInterface.SaveEtcdBackupPolicy:output_type -> cluster.v1alpha1.EtcdBackupPolicy
+	39, // 73: cluster.v1alpha1.ClusterInterface.ListEtcdSnapshots:output_type -> cluster.v1alpha1.EtcdSnapshotList
+	40, // 74: cluster.v1alpha1.ClusterInterface.VerifyEtcdSnapshot:output_type -> cluster.v1alpha1.EtcdSnapshot
+	25, // 75: cluster.v1alpha1.ClusterInterface.RestoreEtcdSnapshot:output_type -> common.Msg
+	25, // 76: cluster.v1alpha1.ClusterInterface.LoadKindImage:output_type -> common.Msg
+	33, // 77: cluster.v1alpha1.ClusterInterface.ImportCluster:output_type -> cluster.v1alpha1.Cluster
+	41, // 78: cluster.v1alpha1.ClusterInterface.GetCloudDriftReport:output_type -> cluster.v1alpha1.CloudDriftReport
+	41, // 79: cluster.v1alpha1.ClusterInterface.RepairCloudDrift:output_type -> cluster.v1alpha1.CloudDriftReport
+	42, // 80: cluster.v1alpha1.ClusterInterface.CollectOrphanedCloudResources:output_type -> cluster.v1alpha1.OrphanedCloudResources
+	43, // 81: cluster.v1alpha1.ClusterInterface.ListNodeGroups:output_type -> cluster.v1alpha1.NodeGroups
+	44, // 82: cluster.v1alpha1.ClusterInterface.SaveNodeGroup:output_type -> cluster.v1alpha1.NodeGroup
+	25, // 83: cluster.v1alpha1.ClusterInterface.DeleteNodeGroup:output_type -> common.Msg
+	45, // 84: cluster.v1alpha1.ClusterInterface.ListNodes:output_type -> cluster.v1alpha1.Nodes
+	46, // 85: cluster.v1alpha1.ClusterInterface.GetNodeSystemInfo:output_type -> cluster.v1alpha1.NodeSystemInfo
+	25, // 86: cluster.v1alpha1.ClusterInterface.RebootNode:output_type -> common.Msg
+	25, // 87: cluster.v1alpha1.ClusterInterface.ReinitNode:output_type -> common.Msg
+	25, // 88: cluster.v1alpha1.ClusterInterface.ReplaceNode:output_type -> common.Msg
+	25, // 89: cluster.v1alpha1.ClusterInterface.RetryNode:output_type -> common.Msg
+	47, // 90: cluster.v1alpha1.ClusterInterface.ExportClusterSpec:output_type -> cluster.v1alpha1.ClusterSpecFile
+	38, // 91: cluster.v1alpha1.ClusterInterface.ApplyClusterSpec:output_type -> cluster.v1alpha1.ClusterPlan
+	48, // 92: cluster.v1alpha1.ClusterInterface.AcceptNodeHostKey:output_type -> cluster.v1alpha1.Node
+	49, // 93: cluster.v1alpha1.ClusterInterface.GetInventory:output_type -> cluster.v1alpha1.Inventory
+	49, // 94: cluster.v1alpha1.ClusterInterface.SaveInventory:output_type -> cluster.v1alpha1.Inventory
+	50, // 95: cluster.v1alpha1.ClusterInterface.RunPreflight:output_type -> cluster.v1alpha1.PreflightReport
+	51, // 96: cluster.v1alpha1.ClusterInterface.ListCertificates:output_type -> cluster.v1alpha1.Certificates
+	25, // 97: cluster.v1alpha1.ClusterInterface.RotateCertificates:output_type -> common.Msg
+	49, // [49:98] is the sub-list for method output_type
+	0,  // [0:49] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -21,22 +21,55 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ClusterInterface_Ping_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/Ping"
-	ClusterInterface_GetClusterProviders_FullMethodName = "/cluster.v1alpha1.ClusterInterface/GetClusterProviders"
-	ClusterInterface_GetClusterStatuses_FullMethodName  = "/cluster.v1alpha1.ClusterInterface/GetClusterStatuses"
-	ClusterInterface_GetClusterLevels_FullMethodName    = "/cluster.v1alpha1.ClusterInterface/GetClusterLevels"
-	ClusterInterface_GetNodeRoles_FullMethodName        = "/cluster.v1alpha1.ClusterInterface/GetNodeRoles"
-	ClusterInterface_GetNodeStatuses_FullMethodName     = "/cluster.v1alpha1.ClusterInterface/GetNodeStatuses"
-	ClusterInterface_GetNodeGroupTypes_FullMethodName   = "/cluster.v1alpha1.ClusterInterface/GetNodeGroupTypes"
-	ClusterInterface_GetResourceTypes_FullMethodName    = "/cluster.v1alpha1.ClusterInterface/GetResourceTypes"
-	ClusterInterface_Get_FullMethodName                 = "/cluster.v1alpha1.ClusterInterface/Get"
-	ClusterInterface_GetClustersByIds_FullMethodName    = "/cluster.v1alpha1.ClusterInterface/GetClustersByIds"
-	ClusterInterface_Save_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/Save"
-	ClusterInterface_List_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/List"
-	ClusterInterface_Delete_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/Delete"
-	ClusterInterface_Start_FullMethodName               = "/cluster.v1alpha1.ClusterInterface/Start"
-	ClusterInterface_Stop_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/Stop"
-	ClusterInterface_GetRegions_FullMethodName          = "/cluster.v1alpha1.ClusterInterface/GetRegions"
+	ClusterInterface_Ping_FullMethodName                          = "/cluster.v1alpha1.ClusterInterface/Ping"
+	ClusterInterface_GetClusterProviders_FullMethodName           = "/cluster.v1alpha1.ClusterInterface/GetClusterProviders"
+	ClusterInterface_GetClusterStatuses_FullMethodName            = "/cluster.v1alpha1.ClusterInterface/GetClusterStatuses"
+	ClusterInterface_GetClusterLevels_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/GetClusterLevels"
+	ClusterInterface_GetNodeRoles_FullMethodName                  = "/cluster.v1alpha1.ClusterInterface/GetNodeRoles"
+	ClusterInterface_GetNodeStatuses_FullMethodName               = "/cluster.v1alpha1.ClusterInterface/GetNodeStatuses"
+	ClusterInterface_GetNodeGroupTypes_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/GetNodeGroupTypes"
+	ClusterInterface_GetResourceTypes_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/GetResourceTypes"
+	ClusterInterface_Get_FullMethodName                           = "/cluster.v1alpha1.ClusterInterface/Get"
+	ClusterInterface_GetClustersByIds_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/GetClustersByIds"
+	ClusterInterface_Save_FullMethodName                          = "/cluster.v1alpha1.ClusterInterface/Save"
+	ClusterInterface_List_FullMethodName                          = "/cluster.v1alpha1.ClusterInterface/List"
+	ClusterInterface_Delete_FullMethodName                        = "/cluster.v1alpha1.ClusterInterface/Delete"
+	ClusterInterface_Start_FullMethodName                         = "/cluster.v1alpha1.ClusterInterface/Start"
+	ClusterInterface_Stop_FullMethodName                          = "/cluster.v1alpha1.ClusterInterface/Stop"
+	ClusterInterface_GetRegions_FullMethodName                    = "/cluster.v1alpha1.ClusterInterface/GetRegions"
+	ClusterInterface_ListEvents_FullMethodName                    = "/cluster.v1alpha1.ClusterInterface/ListEvents"
+	ClusterInterface_GetProvisionSteps_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/GetProvisionSteps"
+	ClusterInterface_RetryProvisionStep_FullMethodName            = "/cluster.v1alpha1.ClusterInterface/RetryProvisionStep"
+	ClusterInterface_SkipProvisionStep_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/SkipProvisionStep"
+	ClusterInterface_Plan_FullMethodName                          = "/cluster.v1alpha1.ClusterInterface/Plan"
+	ClusterInterface_UpgradeCluster_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/UpgradeCluster"
+	ClusterInterface_GetEtcdBackupPolicy_FullMethodName           = "/cluster.v1alpha1.ClusterInterface/GetEtcdBackupPolicy"
+	ClusterInterface_SaveEtcdBackupPolicy_FullMethodName          = "/cluster.v1alpha1.ClusterInterface/SaveEtcdBackupPolicy"
+	ClusterInterface_ListEtcdSnapshots_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/ListEtcdSnapshots"
+	ClusterInterface_VerifyEtcdSnapshot_FullMethodName            = "/cluster.v1alpha1.ClusterInterface/VerifyEtcdSnapshot"
+	ClusterInterface_RestoreEtcdSnapshot_FullMethodName           = "/cluster.v1alpha1.ClusterInterface/RestoreEtcdSnapshot"
+	ClusterInterface_LoadKindImage_FullMethodName                 = "/cluster.v1alpha1.ClusterInterface/LoadKindImage"
+	ClusterInterface_ImportCluster_FullMethodName                 = "/cluster.v1alpha1.ClusterInterface/ImportCluster"
+	ClusterInterface_GetCloudDriftReport_FullMethodName           = "/cluster.v1alpha1.ClusterInterface/GetCloudDriftReport"
+	ClusterInterface_RepairCloudDrift_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/RepairCloudDrift"
+	ClusterInterface_CollectOrphanedCloudResources_FullMethodName = "/cluster.v1alpha1.ClusterInterface/CollectOrphanedCloudResources"
+	ClusterInterface_ListNodeGroups_FullMethodName                = "/cluster.v1alpha1.ClusterInterface/ListNodeGroups"
+	ClusterInterface_SaveNodeGroup_FullMethodName                 = "/cluster.v1alpha1.ClusterInterface/SaveNodeGroup"
+	ClusterInterface_DeleteNodeGroup_FullMethodName               = "/cluster.v1alpha1.ClusterInterface/DeleteNodeGroup"
+	ClusterInterface_ListNodes_FullMethodName                     = "/cluster.v1alpha1.ClusterInterface/ListNodes"
+	ClusterInterface_GetNodeSystemInfo_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/GetNodeSystemInfo"
+	ClusterInterface_RebootNode_FullMethodName                    = "/cluster.v1alpha1.ClusterInterface/RebootNode"
+	ClusterInterface_ReinitNode_FullMethodName                    = "/cluster.v1alpha1.ClusterInterface/ReinitNode"
+	ClusterInterface_ReplaceNode_FullMethodName                   = "/cluster.v1alpha1.ClusterInterface/ReplaceNode"
+	ClusterInterface_RetryNode_FullMethodName                     = "/cluster.v1alpha1.ClusterInterface/RetryNode"
+	ClusterInterface_ExportClusterSpec_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/ExportClusterSpec"
+	ClusterInterface_ApplyClusterSpec_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/ApplyClusterSpec"
+	ClusterInterface_AcceptNodeHostKey_FullMethodName             = "/cluster.v1alpha1.ClusterInterface/AcceptNodeHostKey"
+	ClusterInterface_GetInventory_FullMethodName                  = "/cluster.v1alpha1.ClusterInterface/GetInventory"
+	ClusterInterface_SaveInventory_FullMethodName                 = "/cluster.v1alpha1.ClusterInterface/SaveInventory"
+	ClusterInterface_RunPreflight_FullMethodName                  = "/cluster.v1alpha1.ClusterInterface/RunPreflight"
+	ClusterInterface_ListCertificates_FullMethodName              = "/cluster.v1alpha1.ClusterInterface/ListCertificates"
+	ClusterInterface_RotateCertificates_FullMethodName            = "/cluster.v1alpha1.ClusterInterface/RotateCertificates"
 )
 
 // ClusterInterfaceClient is the client API for ClusterInterface service.
@@ -79,6 +112,72 @@ type ClusterInterfaceClient interface {
 	Stop(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
 	// Get cluster regions
 	GetRegions(ctx context.Context, in *ClusterRegionArgs, opts ...grpc.CallOption) (*Regions, error)
+	// List cluster operation timeline events
+	ListEvents(ctx context.Context, in *ClusterEventListArgs, opts ...grpc.CallOption) (*ClusterEventList, error)
+	// List cluster provisioning steps and their checkpoints
+	GetProvisionSteps(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*ClusterProvisionSteps, error)
+	// Retry a failed cluster provisioning step, provisioning resumes from it
+	RetryProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Skip a failed cluster provisioning step, provisioning resumes after it
+	SkipProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Plan previews the cloud resources, nodes and security rules a start or stop would create, update or delete, without calling the cloud provider
+	Plan(ctx context.Context, in *ClusterPlanArgs, opts ...grpc.CallOption) (*ClusterPlan, error)
+	// UpgradeCluster upgrades kubernetes one minor version at a time, draining and upgrading one node after another
+	UpgradeCluster(ctx context.Context, in *ClusterUpgradeArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Get the etcd snapshot policy of a cluster
+	GetEtcdBackupPolicy(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*EtcdBackupPolicy, error)
+	// Save the etcd snapshot policy of a cluster, snapshots are taken by the periodic cluster check
+	SaveEtcdBackupPolicy(ctx context.Context, in *EtcdBackupPolicy, opts ...grpc.CallOption) (*EtcdBackupPolicy, error)
+	// List the etcd snapshots of a cluster, newest first
+	ListEtcdSnapshots(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*EtcdSnapshotList, error)
+	// Verify the checksum of an etcd snapshot and that etcdutl can read it
+	VerifyEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...grpc.CallOption) (*EtcdSnapshot, error)
+	// Restore the control plane of a cluster from an etcd snapshot
+	RestoreEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Load an image from the docker daemon of the cloud-copilot host into every node of a kind cluster
+	LoadKindImage(ctx context.Context, in *ClusterLoadImageArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Import a running cluster from its kubeconfig and install the cloud-copilot operator on it
+	ImportCluster(ctx context.Context, in *ClusterImportArgs, opts ...grpc.CallOption) (*Cluster, error)
+	// Get the cloud resource drift report of a cluster, refresh compares the stored resources with the cloud again
+	GetCloudDriftReport(ctx context.Context, in *CloudDriftReportArgs, opts ...grpc.CallOption) (*CloudDriftReport, error)
+	// Repair the drifted cloud resources of a cluster, missing resources are created again and security rules are reset
+	RepairCloudDrift(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*CloudDriftReport, error)
+	// Find cloud resources tagged by this cloud-copilot installation whose cluster is gone, confirm deletes the reviewed ones
+	CollectOrphanedCloudResources(ctx context.Context, in *OrphanedCloudResourceArgs, opts ...grpc.CallOption) (*OrphanedCloudResources, error)
+	// List the node groups of a cluster
+	ListNodeGroups(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*NodeGroups, error)
+	// Create or update a node group, a running cluster provisions or removes nodes to match the target size
+	SaveNodeGroup(ctx context.Context, in *NodeGroupArgs, opts ...grpc.CallOption) (*NodeGroup, error)
+	// Delete a node group and remove its nodes
+	DeleteNodeGroup(ctx context.Context, in *NodeGroupIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// List the nodes of a cluster filtered by node group, status and role
+	ListNodes(ctx context.Context, in *NodeListArgs, opts ...grpc.CallOption) (*Nodes, error)
+	// Get the cpu, memory, gpu and disks reported by the node
+	GetNodeSystemInfo(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*NodeSystemInfo, error)
+	// Drain, reboot and uncordon a node
+	RebootNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Run the node initialization and component install on a node again
+	ReinitNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Replace a broken node with a new instance in the same node group
+	ReplaceNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// Run the failed phase of a node again
+	RetryNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
+	// ExportClusterSpec exports the cluster as a versioned spec file that can be kept in git and applied back
+	ExportClusterSpec(ctx context.Context, in *ClusterSpecExportArgs, opts ...grpc.CallOption) (*ClusterSpecFile, error)
+	// ApplyClusterSpec creates the cluster of the spec or moves the existing one to it, applying the same spec twice changes nothing
+	ApplyClusterSpec(ctx context.Context, in *ClusterSpecApplyArgs, opts ...grpc.CallOption) (*ClusterPlan, error)
+	// Pin the ssh host key a node presents after a rebuild rotated it, the fingerprint verified out of band must match
+	AcceptNodeHostKey(ctx context.Context, in *NodeHostKeyArgs, opts ...grpc.CallOption) (*Node, error)
+	// Get the bare metal inventory, credential secrets are never returned
+	GetInventory(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*Inventory, error)
+	// Replace the bare metal inventory from hosts or a csv or ansible ini file, a running cluster joins the added hosts and removes the dropped workers
+	SaveInventory(ctx context.Context, in *InventorySaveArgs, opts ...grpc.CallOption) (*Inventory, error)
+	// Run the preflight checks on the cluster nodes now, failed checks block the install
+	RunPreflight(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*PreflightReport, error)
+	// List the control plane certificates and the days they have left, refresh reads them from the masters first
+	ListCertificates(ctx context.Context, in *CertificateListArgs, opts ...grpc.CallOption) (*Certificates, error)
+	// Renew the control plane certificates and restart the static pods, one master at a time
+	RotateCertificates(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*common.Msg, error)
 }
 
 type clusterInterfaceClient struct {
@@ -249,6 +348,336 @@ func (c *clusterInterfaceClient) GetRegions(ctx context.Context, in *ClusterRegi
 	return out, nil
 }
 
+func (c *clusterInterfaceClient) ListEvents(ctx context.Context, in *ClusterEventListArgs, opts ...grpc.CallOption) (*ClusterEventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterEventList)
+	err := c.cc.Invoke(ctx, ClusterInterface_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) GetProvisionSteps(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*ClusterProvisionSteps, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterProvisionSteps)
+	err := c.cc.Invoke(ctx, ClusterInterface_GetProvisionSteps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RetryProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_RetryProvisionStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) SkipProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_SkipProvisionStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) Plan(ctx context.Context, in *ClusterPlanArgs, opts ...grpc.CallOption) (*ClusterPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterPlan)
+	err := c.cc.Invoke(ctx, ClusterInterface_Plan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) UpgradeCluster(ctx context.Context, in *ClusterUpgradeArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_UpgradeCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) GetEtcdBackupPolicy(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*EtcdBackupPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdBackupPolicy)
+	err := c.cc.Invoke(ctx, ClusterInterface_GetEtcdBackupPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) SaveEtcdBackupPolicy(ctx context.Context, in *EtcdBackupPolicy, opts ...grpc.CallOption) (*EtcdBackupPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdBackupPolicy)
+	err := c.cc.Invoke(ctx, ClusterInterface_SaveEtcdBackupPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ListEtcdSnapshots(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*EtcdSnapshotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdSnapshotList)
+	err := c.cc.Invoke(ctx, ClusterInterface_ListEtcdSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) VerifyEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...grpc.CallOption) (*EtcdSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdSnapshot)
+	err := c.cc.Invoke(ctx, ClusterInterface_VerifyEtcdSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RestoreEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_RestoreEtcdSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) LoadKindImage(ctx context.Context, in *ClusterLoadImageArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_LoadKindImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ImportCluster(ctx context.Context, in *ClusterImportArgs, opts ...grpc.CallOption) (*Cluster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, ClusterInterface_ImportCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) GetCloudDriftReport(ctx context.Context, in *CloudDriftReportArgs, opts ...grpc.CallOption) (*CloudDriftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloudDriftReport)
+	err := c.cc.Invoke(ctx, ClusterInterface_GetCloudDriftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RepairCloudDrift(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*CloudDriftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloudDriftReport)
+	err := c.cc.Invoke(ctx, ClusterInterface_RepairCloudDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) CollectOrphanedCloudResources(ctx context.Context, in *OrphanedCloudResourceArgs, opts ...grpc.CallOption) (*OrphanedCloudResources, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrphanedCloudResources)
+	err := c.cc.Invoke(ctx, ClusterInterface_CollectOrphanedCloudResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ListNodeGroups(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*NodeGroups, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeGroups)
+	err := c.cc.Invoke(ctx, ClusterInterface_ListNodeGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) SaveNodeGroup(ctx context.Context, in *NodeGroupArgs, opts ...grpc.CallOption) (*NodeGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeGroup)
+	err := c.cc.Invoke(ctx, ClusterInterface_SaveNodeGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) DeleteNodeGroup(ctx context.Context, in *NodeGroupIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_DeleteNodeGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ListNodes(ctx context.Context, in *NodeListArgs, opts ...grpc.CallOption) (*Nodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nodes)
+	err := c.cc.Invoke(ctx, ClusterInterface_ListNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) GetNodeSystemInfo(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*NodeSystemInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeSystemInfo)
+	err := c.cc.Invoke(ctx, ClusterInterface_GetNodeSystemInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RebootNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_RebootNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ReinitNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_ReinitNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ReplaceNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_ReplaceNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RetryNode(ctx context.Context, in *NodeIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_RetryNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ExportClusterSpec(ctx context.Context, in *ClusterSpecExportArgs, opts ...grpc.CallOption) (*ClusterSpecFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterSpecFile)
+	err := c.cc.Invoke(ctx, ClusterInterface_ExportClusterSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ApplyClusterSpec(ctx context.Context, in *ClusterSpecApplyArgs, opts ...grpc.CallOption) (*ClusterPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterPlan)
+	err := c.cc.Invoke(ctx, ClusterInterface_ApplyClusterSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) AcceptNodeHostKey(ctx context.Context, in *NodeHostKeyArgs, opts ...grpc.CallOption) (*Node, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Node)
+	err := c.cc.Invoke(ctx, ClusterInterface_AcceptNodeHostKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) GetInventory(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*Inventory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, ClusterInterface_GetInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) SaveInventory(ctx context.Context, in *InventorySaveArgs, opts ...grpc.CallOption) (*Inventory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, ClusterInterface_SaveInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RunPreflight(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*PreflightReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreflightReport)
+	err := c.cc.Invoke(ctx, ClusterInterface_RunPreflight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) ListCertificates(ctx context.Context, in *CertificateListArgs, opts ...grpc.CallOption) (*Certificates, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Certificates)
+	err := c.cc.Invoke(ctx, ClusterInterface_ListCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterInterfaceClient) RotateCertificates(ctx context.Context, in *ClusterIdArgs, opts ...grpc.CallOption) (*common.Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Msg)
+	err := c.cc.Invoke(ctx, ClusterInterface_RotateCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterInterfaceServer is the server API for ClusterInterface service.
 // All implementations must embed UnimplementedClusterInterfaceServer
 // for forward compatibility.
@@ -289,6 +718,72 @@ type ClusterInterfaceServer interface {
 	Stop(context.Context, *ClusterIdArgs) (*common.Msg, error)
 	// Get cluster regions
 	GetRegions(context.Context, *ClusterRegionArgs) (*Regions, error)
+	// List cluster operation timeline events
+	ListEvents(context.Context, *ClusterEventListArgs) (*ClusterEventList, error)
+	// List cluster provisioning steps and their checkpoints
+	GetProvisionSteps(context.Context, *ClusterIdArgs) (*ClusterProvisionSteps, error)
+	// Retry a failed cluster provisioning step, provisioning resumes from it
+	RetryProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error)
+	// Skip a failed cluster provisioning step, provisioning resumes after it
+	SkipProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error)
+	// Plan previews the cloud resources, nodes and security rules a start or stop would create, update or delete, without calling the cloud provider
+	Plan(context.Context, *ClusterPlanArgs) (*ClusterPlan, error)
+	// UpgradeCluster upgrades kubernetes one minor version at a time, draining and upgrading one node after another
+	UpgradeCluster(context.Context, *ClusterUpgradeArgs) (*common.Msg, error)
+	// Get the etcd snapshot policy of a cluster
+	GetEtcdBackupPolicy(context.Context, *ClusterIdArgs) (*EtcdBackupPolicy, error)
+	// Save the etcd snapshot policy of a cluster, snapshots are taken by the periodic cluster check
+	SaveEtcdBackupPolicy(context.Context, *EtcdBackupPolicy) (*EtcdBackupPolicy, error)
+	// List the etcd snapshots of a cluster, newest first
+	ListEtcdSnapshots(context.Context, *ClusterIdArgs) (*EtcdSnapshotList, error)
+	// Verify the checksum of an etcd snapshot and that etcdutl can read it
+	VerifyEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*EtcdSnapshot, error)
+	// Restore the control plane of a cluster from an etcd snapshot
+	RestoreEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*common.Msg, error)
+	// Load an image from the docker daemon of the cloud-copilot host into every node of a kind cluster
+	LoadKindImage(context.Context, *ClusterLoadImageArgs) (*common.Msg, error)
+	// Import a running cluster from its kubeconfig and install the cloud-copilot operator on it
+	ImportCluster(context.Context, *ClusterImportArgs) (*Cluster, error)
+	// Get the cloud resource drift report of a cluster, refresh compares the stored resources with the cloud again
+	GetCloudDriftReport(context.Context, *CloudDriftReportArgs) (*CloudDriftReport, error)
+	// Repair the drifted cloud resources of a cluster, missing resources are created again and security rules are reset
+	RepairCloudDrift(context.Context, *ClusterIdArgs) (*CloudDriftReport, error)
+	// Find cloud resources tagged by this cloud-copilot installation whose cluster is gone, confirm deletes the reviewed ones
+	CollectOrphanedCloudResources(context.Context, *OrphanedCloudResourceArgs) (*OrphanedCloudResources, error)
+	// List the node groups of a cluster
+	ListNodeGroups(context.Context, *ClusterIdArgs) (*NodeGroups, error)
+	// Create or update a node group, a running cluster provisions or removes nodes to match the target size
+	SaveNodeGroup(context.Context, *NodeGroupArgs) (*NodeGroup, error)
+	// Delete a node group and remove its nodes
+	DeleteNodeGroup(context.Context, *NodeGroupIdArgs) (*common.Msg, error)
+	// List the nodes of a cluster filtered by node group, status and role
+	ListNodes(context.Context, *NodeListArgs) (*Nodes, error)
+	// Get the cpu, memory, gpu and disks reported by the node
+	GetNodeSystemInfo(context.Context, *NodeIdArgs) (*NodeSystemInfo, error)
+	// Drain, reboot and uncordon a node
+	RebootNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// Run the node initialization and component install on a node again
+	ReinitNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// Replace a broken node with a new instance in the same node group
+	ReplaceNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// Run the failed phase of a node again
+	RetryNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// ExportClusterSpec exports the cluster as a versioned spec file that can be kept in git and applied back
+	ExportClusterSpec(context.Context, *ClusterSpecExportArgs) (*ClusterSpecFile, error)
+	// ApplyClusterSpec creates the cluster of the spec or moves the existing one to it, applying the same spec twice changes nothing
+	ApplyClusterSpec(context.Context, *ClusterSpecApplyArgs) (*ClusterPlan, error)
+	// Pin the ssh host key a node presents after a rebuild rotated it, the fingerprint verified out of band must match
+	AcceptNodeHostKey(context.Context, *NodeHostKeyArgs) (*Node, error)
+	// Get the bare metal inventory, credential secrets are never returned
+	GetInventory(context.Context, *ClusterIdArgs) (*Inventory, error)
+	// Replace the bare metal inventory from hosts or a csv or ansible ini file, a running cluster joins the added hosts and removes the dropped workers
+	SaveInventory(context.Context, *InventorySaveArgs) (*Inventory, error)
+	// Run the preflight checks on the cluster nodes now, failed checks block the install
+	RunPreflight(context.Context, *ClusterIdArgs) (*PreflightReport, error)
+	// List the control plane certificates and the days they have left, refresh reads them from the masters first
+	ListCertificates(context.Context, *CertificateListArgs) (*Certificates, error)
+	// Renew the control plane certificates and restart the static pods, one master at a time
+	RotateCertificates(context.Context, *ClusterIdArgs) (*common.Msg, error)
 	mustEmbedUnimplementedClusterInterfaceServer()
 }
 
@@ -335,323 +830,1016 @@ func (UnimplementedClusterInterfaceServer) Save(context.Context, *ClusterSaveArg
 func (UnimplementedClusterInterfaceServer) List(context.Context, *ClusterListArgs) (*ClusterList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedClusterInterfaceServer) Delete(context.Context, *ClusterIdArgs) (*common.Msg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedClusterInterfaceServer) Delete(context.Context, *ClusterIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedClusterInterfaceServer) Start(context.Context, *ClusterIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedClusterInterfaceServer) Stop(context.Context, *ClusterIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetRegions(context.Context, *ClusterRegionArgs) (*Regions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegions not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ListEvents(context.Context, *ClusterEventListArgs) (*ClusterEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetProvisionSteps(context.Context, *ClusterIdArgs) (*ClusterProvisionSteps, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvisionSteps not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RetryProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryProvisionStep not implemented")
+}
+func (UnimplementedClusterInterfaceServer) SkipProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipProvisionStep not implemented")
+}
+func (UnimplementedClusterInterfaceServer) Plan(context.Context, *ClusterPlanArgs) (*ClusterPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (UnimplementedClusterInterfaceServer) UpgradeCluster(context.Context, *ClusterUpgradeArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetEtcdBackupPolicy(context.Context, *ClusterIdArgs) (*EtcdBackupPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEtcdBackupPolicy not implemented")
+}
+func (UnimplementedClusterInterfaceServer) SaveEtcdBackupPolicy(context.Context, *EtcdBackupPolicy) (*EtcdBackupPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveEtcdBackupPolicy not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ListEtcdSnapshots(context.Context, *ClusterIdArgs) (*EtcdSnapshotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEtcdSnapshots not implemented")
+}
+func (UnimplementedClusterInterfaceServer) VerifyEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*EtcdSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEtcdSnapshot not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RestoreEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEtcdSnapshot not implemented")
+}
+func (UnimplementedClusterInterfaceServer) LoadKindImage(context.Context, *ClusterLoadImageArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadKindImage not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ImportCluster(context.Context, *ClusterImportArgs) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCluster not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetCloudDriftReport(context.Context, *CloudDriftReportArgs) (*CloudDriftReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloudDriftReport not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RepairCloudDrift(context.Context, *ClusterIdArgs) (*CloudDriftReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairCloudDrift not implemented")
+}
+func (UnimplementedClusterInterfaceServer) CollectOrphanedCloudResources(context.Context, *OrphanedCloudResourceArgs) (*OrphanedCloudResources, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectOrphanedCloudResources not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ListNodeGroups(context.Context, *ClusterIdArgs) (*NodeGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeGroups not implemented")
+}
+func (UnimplementedClusterInterfaceServer) SaveNodeGroup(context.Context, *NodeGroupArgs) (*NodeGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveNodeGroup not implemented")
+}
+func (UnimplementedClusterInterfaceServer) DeleteNodeGroup(context.Context, *NodeGroupIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNodeGroup not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ListNodes(context.Context, *NodeListArgs) (*Nodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodes not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetNodeSystemInfo(context.Context, *NodeIdArgs) (*NodeSystemInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeSystemInfo not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RebootNode(context.Context, *NodeIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootNode not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ReinitNode(context.Context, *NodeIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinitNode not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ReplaceNode(context.Context, *NodeIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceNode not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RetryNode(context.Context, *NodeIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryNode not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ExportClusterSpec(context.Context, *ClusterSpecExportArgs) (*ClusterSpecFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportClusterSpec not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ApplyClusterSpec(context.Context, *ClusterSpecApplyArgs) (*ClusterPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyClusterSpec not implemented")
+}
+func (UnimplementedClusterInterfaceServer) AcceptNodeHostKey(context.Context, *NodeHostKeyArgs) (*Node, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptNodeHostKey not implemented")
+}
+func (UnimplementedClusterInterfaceServer) GetInventory(context.Context, *ClusterIdArgs) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
+}
+func (UnimplementedClusterInterfaceServer) SaveInventory(context.Context, *InventorySaveArgs) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveInventory not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RunPreflight(context.Context, *ClusterIdArgs) (*PreflightReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPreflight not implemented")
+}
+func (UnimplementedClusterInterfaceServer) ListCertificates(context.Context, *CertificateListArgs) (*Certificates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
+func (UnimplementedClusterInterfaceServer) RotateCertificates(context.Context, *ClusterIdArgs) (*common.Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCertificates not implemented")
+}
+func (UnimplementedClusterInterfaceServer) mustEmbedUnimplementedClusterInterfaceServer() {}
+func (UnimplementedClusterInterfaceServer) testEmbeddedByValue()                          {}
+
+// UnsafeClusterInterfaceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterInterfaceServer will
+// result in compilation errors.
+type UnsafeClusterInterfaceServer interface {
+	mustEmbedUnimplementedClusterInterfaceServer()
+}
+
+func RegisterClusterInterfaceServer(s grpc.ServiceRegistrar, srv ClusterInterfaceServer) {
+	// If the following call pancis, it indicates UnimplementedClusterInterfaceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClusterInterface_ServiceDesc, srv)
+}
+
+func _ClusterInterface_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Ping(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetClusterProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetClusterProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetClusterProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetClusterProviders(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetClusterStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetClusterStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetClusterStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetClusterStatuses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetClusterLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetClusterLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetClusterLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetClusterLevels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetNodeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetNodeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetNodeRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetNodeRoles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetNodeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetNodeStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetNodeStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetNodeStatuses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetNodeGroupTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetNodeGroupTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetNodeGroupTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetNodeGroupTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetResourceTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetResourceTypes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Get(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetClustersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetClustersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetClustersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetClustersByIds(ctx, req.(*ClusterIdsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterSaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Save(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Save_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Save(ctx, req.(*ClusterSaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterListArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).List(ctx, req.(*ClusterListArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Delete(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Start_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Start(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Stop(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRegionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetRegions(ctx, req.(*ClusterRegionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterEventListArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).ListEvents(ctx, req.(*ClusterEventListArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetProvisionSteps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetProvisionSteps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetProvisionSteps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetProvisionSteps(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_RetryProvisionStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterProvisionStepArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).RetryProvisionStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_RetryProvisionStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).RetryProvisionStep(ctx, req.(*ClusterProvisionStepArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_SkipProvisionStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterProvisionStepArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).SkipProvisionStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_SkipProvisionStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).SkipProvisionStep(ctx, req.(*ClusterProvisionStepArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterPlanArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_Plan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).Plan(ctx, req.(*ClusterPlanArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterUpgradeArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).UpgradeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_UpgradeCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).UpgradeCluster(ctx, req.(*ClusterUpgradeArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_GetEtcdBackupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetEtcdBackupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetEtcdBackupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetEtcdBackupPolicy(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_SaveEtcdBackupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdBackupPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).SaveEtcdBackupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_SaveEtcdBackupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).SaveEtcdBackupPolicy(ctx, req.(*EtcdBackupPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_ListEtcdSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).ListEtcdSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_ListEtcdSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).ListEtcdSnapshots(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_VerifyEtcdSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdSnapshotArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).VerifyEtcdSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_VerifyEtcdSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).VerifyEtcdSnapshot(ctx, req.(*EtcdSnapshotArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_RestoreEtcdSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdSnapshotArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).RestoreEtcdSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_RestoreEtcdSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).RestoreEtcdSnapshot(ctx, req.(*EtcdSnapshotArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedClusterInterfaceServer) Start(context.Context, *ClusterIdArgs) (*common.Msg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+
+func _ClusterInterface_LoadKindImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterLoadImageArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).LoadKindImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_LoadKindImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).LoadKindImage(ctx, req.(*ClusterLoadImageArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedClusterInterfaceServer) Stop(context.Context, *ClusterIdArgs) (*common.Msg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+
+func _ClusterInterface_ImportCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterImportArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).ImportCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_ImportCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).ImportCluster(ctx, req.(*ClusterImportArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedClusterInterfaceServer) GetRegions(context.Context, *ClusterRegionArgs) (*Regions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegions not implemented")
+
+func _ClusterInterface_GetCloudDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloudDriftReportArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).GetCloudDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_GetCloudDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).GetCloudDriftReport(ctx, req.(*CloudDriftReportArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedClusterInterfaceServer) mustEmbedUnimplementedClusterInterfaceServer() {}
-func (UnimplementedClusterInterfaceServer) testEmbeddedByValue()                          {}
 
-// UnsafeClusterInterfaceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ClusterInterfaceServer will
-// result in compilation errors.
-type UnsafeClusterInterfaceServer interface {
-	mustEmbedUnimplementedClusterInterfaceServer()
+func _ClusterInterface_RepairCloudDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).RepairCloudDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_RepairCloudDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).RepairCloudDrift(ctx, req.(*ClusterIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterClusterInterfaceServer(s grpc.ServiceRegistrar, srv ClusterInterfaceServer) {
-	// If the following call pancis, it indicates UnimplementedClusterInterfaceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ClusterInterface_CollectOrphanedCloudResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrphanedCloudResourceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ClusterInterface_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).CollectOrphanedCloudResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_CollectOrphanedCloudResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).CollectOrphanedCloudResources(ctx, req.(*OrphanedCloudResourceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_ListNodeGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Ping(ctx, in)
+		return srv.(ClusterInterfaceServer).ListNodeGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Ping_FullMethodName,
+		FullMethod: ClusterInterface_ListNodeGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Ping(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).ListNodeGroups(ctx, req.(*ClusterIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetClusterProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_SaveNodeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeGroupArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetClusterProviders(ctx, in)
+		return srv.(ClusterInterfaceServer).SaveNodeGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetClusterProviders_FullMethodName,
+		FullMethod: ClusterInterface_SaveNodeGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetClusterProviders(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).SaveNodeGroup(ctx, req.(*NodeGroupArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetClusterStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_DeleteNodeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeGroupIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetClusterStatuses(ctx, in)
+		return srv.(ClusterInterfaceServer).DeleteNodeGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetClusterStatuses_FullMethodName,
+		FullMethod: ClusterInterface_DeleteNodeGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetClusterStatuses(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).DeleteNodeGroup(ctx, req.(*NodeGroupIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetClusterLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeListArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetClusterLevels(ctx, in)
+		return srv.(ClusterInterfaceServer).ListNodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetClusterLevels_FullMethodName,
+		FullMethod: ClusterInterface_ListNodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetClusterLevels(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).ListNodes(ctx, req.(*NodeListArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetNodeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_GetNodeSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetNodeRoles(ctx, in)
+		return srv.(ClusterInterfaceServer).GetNodeSystemInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetNodeRoles_FullMethodName,
+		FullMethod: ClusterInterface_GetNodeSystemInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetNodeRoles(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).GetNodeSystemInfo(ctx, req.(*NodeIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetNodeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_RebootNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetNodeStatuses(ctx, in)
+		return srv.(ClusterInterfaceServer).RebootNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetNodeStatuses_FullMethodName,
+		FullMethod: ClusterInterface_RebootNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetNodeStatuses(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).RebootNode(ctx, req.(*NodeIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetNodeGroupTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_ReinitNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetNodeGroupTypes(ctx, in)
+		return srv.(ClusterInterfaceServer).ReinitNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetNodeGroupTypes_FullMethodName,
+		FullMethod: ClusterInterface_ReinitNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetNodeGroupTypes(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).ReinitNode(ctx, req.(*NodeIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ClusterInterface_ReplaceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetResourceTypes(ctx, in)
+		return srv.(ClusterInterfaceServer).ReplaceNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetResourceTypes_FullMethodName,
+		FullMethod: ClusterInterface_ReplaceNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetResourceTypes(ctx, req.(*emptypb.Empty))
+		return srv.(ClusterInterfaceServer).ReplaceNode(ctx, req.(*NodeIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterIdArgs)
+func _ClusterInterface_RetryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Get(ctx, in)
+		return srv.(ClusterInterfaceServer).RetryNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Get_FullMethodName,
+		FullMethod: ClusterInterface_RetryNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Get(ctx, req.(*ClusterIdArgs))
+		return srv.(ClusterInterfaceServer).RetryNode(ctx, req.(*NodeIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetClustersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterIdsArgs)
+func _ClusterInterface_ExportClusterSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterSpecExportArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetClustersByIds(ctx, in)
+		return srv.(ClusterInterfaceServer).ExportClusterSpec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetClustersByIds_FullMethodName,
+		FullMethod: ClusterInterface_ExportClusterSpec_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetClustersByIds(ctx, req.(*ClusterIdsArgs))
+		return srv.(ClusterInterfaceServer).ExportClusterSpec(ctx, req.(*ClusterSpecExportArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterSaveArgs)
+func _ClusterInterface_ApplyClusterSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterSpecApplyArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Save(ctx, in)
+		return srv.(ClusterInterfaceServer).ApplyClusterSpec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Save_FullMethodName,
+		FullMethod: ClusterInterface_ApplyClusterSpec_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Save(ctx, req.(*ClusterSaveArgs))
+		return srv.(ClusterInterfaceServer).ApplyClusterSpec(ctx, req.(*ClusterSpecApplyArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterListArgs)
+func _ClusterInterface_AcceptNodeHostKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeHostKeyArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).List(ctx, in)
+		return srv.(ClusterInterfaceServer).AcceptNodeHostKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_List_FullMethodName,
+		FullMethod: ClusterInterface_AcceptNodeHostKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).List(ctx, req.(*ClusterListArgs))
+		return srv.(ClusterInterfaceServer).AcceptNodeHostKey(ctx, req.(*NodeHostKeyArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ClusterInterface_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Delete(ctx, in)
+		return srv.(ClusterInterfaceServer).GetInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Delete_FullMethodName,
+		FullMethod: ClusterInterface_GetInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Delete(ctx, req.(*ClusterIdArgs))
+		return srv.(ClusterInterfaceServer).GetInventory(ctx, req.(*ClusterIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterIdArgs)
+func _ClusterInterface_SaveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InventorySaveArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Start(ctx, in)
+		return srv.(ClusterInterfaceServer).SaveInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Start_FullMethodName,
+		FullMethod: ClusterInterface_SaveInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Start(ctx, req.(*ClusterIdArgs))
+		return srv.(ClusterInterfaceServer).SaveInventory(ctx, req.(*InventorySaveArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ClusterInterface_RunPreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterIdArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).Stop(ctx, in)
+		return srv.(ClusterInterfaceServer).RunPreflight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_Stop_FullMethodName,
+		FullMethod: ClusterInterface_RunPreflight_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).Stop(ctx, req.(*ClusterIdArgs))
+		return srv.(ClusterInterfaceServer).RunPreflight(ctx, req.(*ClusterIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterInterface_GetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterRegionArgs)
+func _ClusterInterface_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateListArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterInterfaceServer).GetRegions(ctx, in)
+		return srv.(ClusterInterfaceServer).ListCertificates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterInterface_GetRegions_FullMethodName,
+		FullMethod: ClusterInterface_ListCertificates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterInterfaceServer).GetRegions(ctx, req.(*ClusterRegionArgs))
+		return srv.(ClusterInterfaceServer).ListCertificates(ctx, req.(*CertificateListArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterInterface_RotateCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInterfaceServer).RotateCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInterface_RotateCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInterfaceServer).RotateCertificates(ctx, req.(*ClusterIdArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -727,6 +1915,138 @@ var ClusterInterface_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRegions",
 			Handler:    _ClusterInterface_GetRegions_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _ClusterInterface_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetProvisionSteps",
+			Handler:    _ClusterInterface_GetProvisionSteps_Handler,
+		},
+		{
+			MethodName: "RetryProvisionStep",
+			Handler:    _ClusterInterface_RetryProvisionStep_Handler,
+		},
+		{
+			MethodName: "SkipProvisionStep",
+			Handler:    _ClusterInterface_SkipProvisionStep_Handler,
+		},
+		{
+			MethodName: "Plan",
+			Handler:    _ClusterInterface_Plan_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _ClusterInterface_UpgradeCluster_Handler,
+		},
+		{
+			MethodName: "GetEtcdBackupPolicy",
+			Handler:    _ClusterInterface_GetEtcdBackupPolicy_Handler,
+		},
+		{
+			MethodName: "SaveEtcdBackupPolicy",
+			Handler:    _ClusterInterface_SaveEtcdBackupPolicy_Handler,
+		},
+		{
+			MethodName: "ListEtcdSnapshots",
+			Handler:    _ClusterInterface_ListEtcdSnapshots_Handler,
+		},
+		{
+			MethodName: "VerifyEtcdSnapshot",
+			Handler:    _ClusterInterface_VerifyEtcdSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreEtcdSnapshot",
+			Handler:    _ClusterInterface_RestoreEtcdSnapshot_Handler,
+		},
+		{
+			MethodName: "LoadKindImage",
+			Handler:    _ClusterInterface_LoadKindImage_Handler,
+		},
+		{
+			MethodName: "ImportCluster",
+			Handler:    _ClusterInterface_ImportCluster_Handler,
+		},
+		{
+			MethodName: "GetCloudDriftReport",
+			Handler:    _ClusterInterface_GetCloudDriftReport_Handler,
+		},
+		{
+			MethodName: "RepairCloudDrift",
+			Handler:    _ClusterInterface_RepairCloudDrift_Handler,
+		},
+		{
+			MethodName: "CollectOrphanedCloudResources",
+			Handler:    _ClusterInterface_CollectOrphanedCloudResources_Handler,
+		},
+		{
+			MethodName: "ListNodeGroups",
+			Handler:    _ClusterInterface_ListNodeGroups_Handler,
+		},
+		{
+			MethodName: "SaveNodeGroup",
+			Handler:    _ClusterInterface_SaveNodeGroup_Handler,
+		},
+		{
+			MethodName: "DeleteNodeGroup",
+			Handler:    _ClusterInterface_DeleteNodeGroup_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _ClusterInterface_ListNodes_Handler,
+		},
+		{
+			MethodName: "GetNodeSystemInfo",
+			Handler:    _ClusterInterface_GetNodeSystemInfo_Handler,
+		},
+		{
+			MethodName: "RebootNode",
+			Handler:    _ClusterInterface_RebootNode_Handler,
+		},
+		{
+			MethodName: "ReinitNode",
+			Handler:    _ClusterInterface_ReinitNode_Handler,
+		},
+		{
+			MethodName: "ReplaceNode",
+			Handler:    _ClusterInterface_ReplaceNode_Handler,
+		},
+		{
+			MethodName: "RetryNode",
+			Handler:    _ClusterInterface_RetryNode_Handler,
+		},
+		{
+			MethodName: "ExportClusterSpec",
+			Handler:    _ClusterInterface_ExportClusterSpec_Handler,
+		},
+		{
+			MethodName: "ApplyClusterSpec",
+			Handler:    _ClusterInterface_ApplyClusterSpec_Handler,
+		},
+		{
+			MethodName: "AcceptNodeHostKey",
+			Handler:    _ClusterInterface_AcceptNodeHostKey_Handler,
+		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _ClusterInterface_GetInventory_Handler,
+		},
+		{
+			MethodName: "SaveInventory",
+			Handler:    _ClusterInterface_SaveInventory_Handler,
+		},
+		{
+			MethodName: "RunPreflight",
+			Handler:    _ClusterInterface_RunPreflight_Handler,
+		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _ClusterInterface_ListCertificates_Handler,
+		},
+		{
+			MethodName: "RotateCertificates",
+			Handler:    _ClusterInterface_RotateCertificates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/cluster/v1alpha1/cluster.proto",
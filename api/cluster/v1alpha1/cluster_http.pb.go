@@ -21,28 +21,73 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationClusterInterfaceAcceptNodeHostKey = "/cluster.v1alpha1.ClusterInterface/AcceptNodeHostKey"
+const OperationClusterInterfaceApplyClusterSpec = "/cluster.v1alpha1.ClusterInterface/ApplyClusterSpec"
+const OperationClusterInterfaceCollectOrphanedCloudResources = "/cluster.v1alpha1.ClusterInterface/CollectOrphanedCloudResources"
 const OperationClusterInterfaceDelete = "/cluster.v1alpha1.ClusterInterface/Delete"
+const OperationClusterInterfaceDeleteNodeGroup = "/cluster.v1alpha1.ClusterInterface/DeleteNodeGroup"
+const OperationClusterInterfaceExportClusterSpec = "/cluster.v1alpha1.ClusterInterface/ExportClusterSpec"
 const OperationClusterInterfaceGet = "/cluster.v1alpha1.ClusterInterface/Get"
+const OperationClusterInterfaceGetCloudDriftReport = "/cluster.v1alpha1.ClusterInterface/GetCloudDriftReport"
 const OperationClusterInterfaceGetClusterLevels = "/cluster.v1alpha1.ClusterInterface/GetClusterLevels"
 const OperationClusterInterfaceGetClusterProviders = "/cluster.v1alpha1.ClusterInterface/GetClusterProviders"
 const OperationClusterInterfaceGetClusterStatuses = "/cluster.v1alpha1.ClusterInterface/GetClusterStatuses"
 const OperationClusterInterfaceGetClustersByIds = "/cluster.v1alpha1.ClusterInterface/GetClustersByIds"
+const OperationClusterInterfaceGetEtcdBackupPolicy = "/cluster.v1alpha1.ClusterInterface/GetEtcdBackupPolicy"
+const OperationClusterInterfaceGetInventory = "/cluster.v1alpha1.ClusterInterface/GetInventory"
 const OperationClusterInterfaceGetNodeGroupTypes = "/cluster.v1alpha1.ClusterInterface/GetNodeGroupTypes"
 const OperationClusterInterfaceGetNodeRoles = "/cluster.v1alpha1.ClusterInterface/GetNodeRoles"
 const OperationClusterInterfaceGetNodeStatuses = "/cluster.v1alpha1.ClusterInterface/GetNodeStatuses"
+const OperationClusterInterfaceGetNodeSystemInfo = "/cluster.v1alpha1.ClusterInterface/GetNodeSystemInfo"
+const OperationClusterInterfaceGetProvisionSteps = "/cluster.v1alpha1.ClusterInterface/GetProvisionSteps"
 const OperationClusterInterfaceGetRegions = "/cluster.v1alpha1.ClusterInterface/GetRegions"
 const OperationClusterInterfaceGetResourceTypes = "/cluster.v1alpha1.ClusterInterface/GetResourceTypes"
+const OperationClusterInterfaceImportCluster = "/cluster.v1alpha1.ClusterInterface/ImportCluster"
 const OperationClusterInterfaceList = "/cluster.v1alpha1.ClusterInterface/List"
+const OperationClusterInterfaceListCertificates = "/cluster.v1alpha1.ClusterInterface/ListCertificates"
+const OperationClusterInterfaceListEtcdSnapshots = "/cluster.v1alpha1.ClusterInterface/ListEtcdSnapshots"
+const OperationClusterInterfaceListEvents = "/cluster.v1alpha1.ClusterInterface/ListEvents"
+const OperationClusterInterfaceListNodeGroups = "/cluster.v1alpha1.ClusterInterface/ListNodeGroups"
+const OperationClusterInterfaceListNodes = "/cluster.v1alpha1.ClusterInterface/ListNodes"
+const OperationClusterInterfaceLoadKindImage = "/cluster.v1alpha1.ClusterInterface/LoadKindImage"
 const OperationClusterInterfacePing = "/cluster.v1alpha1.ClusterInterface/Ping"
+const OperationClusterInterfacePlan = "/cluster.v1alpha1.ClusterInterface/Plan"
+const OperationClusterInterfaceRebootNode = "/cluster.v1alpha1.ClusterInterface/RebootNode"
+const OperationClusterInterfaceReinitNode = "/cluster.v1alpha1.ClusterInterface/ReinitNode"
+const OperationClusterInterfaceRepairCloudDrift = "/cluster.v1alpha1.ClusterInterface/RepairCloudDrift"
+const OperationClusterInterfaceReplaceNode = "/cluster.v1alpha1.ClusterInterface/ReplaceNode"
+const OperationClusterInterfaceRestoreEtcdSnapshot = "/cluster.v1alpha1.ClusterInterface/RestoreEtcdSnapshot"
+const OperationClusterInterfaceRetryNode = "/cluster.v1alpha1.ClusterInterface/RetryNode"
+const OperationClusterInterfaceRetryProvisionStep = "/cluster.v1alpha1.ClusterInterface/RetryProvisionStep"
+const OperationClusterInterfaceRotateCertificates = "/cluster.v1alpha1.ClusterInterface/RotateCertificates"
+const OperationClusterInterfaceRunPreflight = "/cluster.v1alpha1.ClusterInterface/RunPreflight"
 const OperationClusterInterfaceSave = "/cluster.v1alpha1.ClusterInterface/Save"
+const OperationClusterInterfaceSaveEtcdBackupPolicy = "/cluster.v1alpha1.ClusterInterface/SaveEtcdBackupPolicy"
+const OperationClusterInterfaceSaveInventory = "/cluster.v1alpha1.ClusterInterface/SaveInventory"
+const OperationClusterInterfaceSaveNodeGroup = "/cluster.v1alpha1.ClusterInterface/SaveNodeGroup"
+const OperationClusterInterfaceSkipProvisionStep = "/cluster.v1alpha1.ClusterInterface/SkipProvisionStep"
 const OperationClusterInterfaceStart = "/cluster.v1alpha1.ClusterInterface/Start"
 const OperationClusterInterfaceStop = "/cluster.v1alpha1.ClusterInterface/Stop"
+const OperationClusterInterfaceUpgradeCluster = "/cluster.v1alpha1.ClusterInterface/UpgradeCluster"
+const OperationClusterInterfaceVerifyEtcdSnapshot = "/cluster.v1alpha1.ClusterInterface/VerifyEtcdSnapshot"
 
 type ClusterInterfaceHTTPServer interface {
+	// AcceptNodeHostKey Pin the ssh host key a node presents after a rebuild rotated it, the fingerprint verified out of band must match
+	AcceptNodeHostKey(context.Context, *NodeHostKeyArgs) (*Node, error)
+	// ApplyClusterSpec ApplyClusterSpec creates the cluster of the spec or moves the existing one to it, applying the same spec twice changes nothing
+	ApplyClusterSpec(context.Context, *ClusterSpecApplyArgs) (*ClusterPlan, error)
+	// CollectOrphanedCloudResources Find cloud resources tagged by this cloud-copilot installation whose cluster is gone, confirm deletes the reviewed ones
+	CollectOrphanedCloudResources(context.Context, *OrphanedCloudResourceArgs) (*OrphanedCloudResources, error)
 	// Delete Delete cluster.
 	Delete(context.Context, *ClusterIdArgs) (*common.Msg, error)
+	// DeleteNodeGroup Delete a node group and remove its nodes
+	DeleteNodeGroup(context.Context, *NodeGroupIdArgs) (*common.Msg, error)
+	// ExportClusterSpec ExportClusterSpec exports the cluster as a versioned spec file that can be kept in git and applied back
+	ExportClusterSpec(context.Context, *ClusterSpecExportArgs) (*ClusterSpecFile, error)
 	// Get Get cluster by id.
 	Get(context.Context, *ClusterIdArgs) (*Cluster, error)
+	// GetCloudDriftReport Get the cloud resource drift report of a cluster, refresh compares the stored resources with the cloud again
+	GetCloudDriftReport(context.Context, *CloudDriftReportArgs) (*CloudDriftReport, error)
 	// GetClusterLevels @mcp: reject
 	GetClusterLevels(context.Context, *emptypb.Empty) (*ClusterLevels, error)
 	// GetClusterProviders GetClusterProviders returns the available cluster providers.
@@ -52,27 +97,81 @@ type ClusterInterfaceHTTPServer interface {
 	GetClusterStatuses(context.Context, *emptypb.Empty) (*ClusterStatuses, error)
 	// GetClustersByIds Get clusters by ids.
 	GetClustersByIds(context.Context, *ClusterIdsArgs) (*ClusterList, error)
+	// GetEtcdBackupPolicy Get the etcd snapshot policy of a cluster
+	GetEtcdBackupPolicy(context.Context, *ClusterIdArgs) (*EtcdBackupPolicy, error)
+	// GetInventory Get the bare metal inventory, credential secrets are never returned
+	GetInventory(context.Context, *ClusterIdArgs) (*Inventory, error)
 	// GetNodeGroupTypes @mcp: reject
 	GetNodeGroupTypes(context.Context, *emptypb.Empty) (*NodeGroupTypes, error)
 	// GetNodeRoles @mcp: reject
 	GetNodeRoles(context.Context, *emptypb.Empty) (*NodeRoles, error)
 	// GetNodeStatuses @mcp: reject
 	GetNodeStatuses(context.Context, *emptypb.Empty) (*NodeStatuses, error)
+	// GetNodeSystemInfo Get the cpu, memory, gpu and disks reported by the node
+	GetNodeSystemInfo(context.Context, *NodeIdArgs) (*NodeSystemInfo, error)
+	// GetProvisionSteps List cluster provisioning steps and their checkpoints
+	GetProvisionSteps(context.Context, *ClusterIdArgs) (*ClusterProvisionSteps, error)
 	// GetRegions Get cluster regions
 	GetRegions(context.Context, *ClusterRegionArgs) (*Regions, error)
 	// GetResourceTypes @mcp: reject
 	GetResourceTypes(context.Context, *emptypb.Empty) (*ResourceTypes, error)
+	// ImportCluster Import a running cluster from its kubeconfig and install the cloud-copilot operator on it
+	ImportCluster(context.Context, *ClusterImportArgs) (*Cluster, error)
 	// List List returns a list of clusters based on the provided arguments.
 	List(context.Context, *ClusterListArgs) (*ClusterList, error)
+	// ListCertificates List the control plane certificates and the days they have left, refresh reads them from the masters first
+	ListCertificates(context.Context, *CertificateListArgs) (*Certificates, error)
+	// ListEtcdSnapshots List the etcd snapshots of a cluster, newest first
+	ListEtcdSnapshots(context.Context, *ClusterIdArgs) (*EtcdSnapshotList, error)
+	// ListEvents List cluster operation timeline events
+	ListEvents(context.Context, *ClusterEventListArgs) (*ClusterEventList, error)
+	// ListNodeGroups List the node groups of a cluster
+	ListNodeGroups(context.Context, *ClusterIdArgs) (*NodeGroups, error)
+	// ListNodes List the nodes of a cluster filtered by node group, status and role
+	ListNodes(context.Context, *NodeListArgs) (*Nodes, error)
+	// LoadKindImage Load an image from the docker daemon of the cloud-copilot host into every node of a kind cluster
+	LoadKindImage(context.Context, *ClusterLoadImageArgs) (*common.Msg, error)
 	// Ping Ping the cluster service.
 	// @mcp: reject
 	Ping(context.Context, *emptypb.Empty) (*common.Msg, error)
+	// Plan Plan previews the cloud resources, nodes and security rules a start or stop would create, update or delete, without calling the cloud provider
+	Plan(context.Context, *ClusterPlanArgs) (*ClusterPlan, error)
+	// RebootNode Drain, reboot and uncordon a node
+	RebootNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// ReinitNode Run the node initialization and component install on a node again
+	ReinitNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// RepairCloudDrift Repair the drifted cloud resources of a cluster, missing resources are created again and security rules are reset
+	RepairCloudDrift(context.Context, *ClusterIdArgs) (*CloudDriftReport, error)
+	// ReplaceNode Replace a broken node with a new instance in the same node group
+	ReplaceNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// RestoreEtcdSnapshot Restore the control plane of a cluster from an etcd snapshot
+	RestoreEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*common.Msg, error)
+	// RetryNode Run the failed phase of a node again
+	RetryNode(context.Context, *NodeIdArgs) (*common.Msg, error)
+	// RetryProvisionStep Retry a failed cluster provisioning step, provisioning resumes from it
+	RetryProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error)
+	// RotateCertificates Renew the control plane certificates and restart the static pods, one master at a time
+	RotateCertificates(context.Context, *ClusterIdArgs) (*common.Msg, error)
+	// RunPreflight Run the preflight checks on the cluster nodes now, failed checks block the install
+	RunPreflight(context.Context, *ClusterIdArgs) (*PreflightReport, error)
 	// Save Save cluster.
 	Save(context.Context, *ClusterSaveArgs) (*Cluster, error)
+	// SaveEtcdBackupPolicy Save the etcd snapshot policy of a cluster, snapshots are taken by the periodic cluster check
+	SaveEtcdBackupPolicy(context.Context, *EtcdBackupPolicy) (*EtcdBackupPolicy, error)
+	// SaveInventory Replace the bare metal inventory from hosts or a csv or ansible ini file, a running cluster joins the added hosts and removes the dropped workers
+	SaveInventory(context.Context, *InventorySaveArgs) (*Inventory, error)
+	// SaveNodeGroup Create or update a node group, a running cluster provisions or removes nodes to match the target size
+	SaveNodeGroup(context.Context, *NodeGroupArgs) (*NodeGroup, error)
+	// SkipProvisionStep Skip a failed cluster provisioning step, provisioning resumes after it
+	SkipProvisionStep(context.Context, *ClusterProvisionStepArgs) (*common.Msg, error)
 	// Start Start cluster: create cluster and start all nodes
 	Start(context.Context, *ClusterIdArgs) (*common.Msg, error)
 	// Stop Stop cluster: stop all nodes and delete cluster
 	Stop(context.Context, *ClusterIdArgs) (*common.Msg, error)
+	// UpgradeCluster UpgradeCluster upgrades kubernetes one minor version at a time, draining and upgrading one node after another
+	UpgradeCluster(context.Context, *ClusterUpgradeArgs) (*common.Msg, error)
+	// VerifyEtcdSnapshot Verify the checksum of an etcd snapshot and that etcdutl can read it
+	VerifyEtcdSnapshot(context.Context, *EtcdSnapshotArgs) (*EtcdSnapshot, error)
 }
 
 func RegisterClusterInterfaceHTTPServer(s *http.Server, srv ClusterInterfaceHTTPServer) {
@@ -93,6 +192,39 @@ func RegisterClusterInterfaceHTTPServer(s *http.Server, srv ClusterInterfaceHTTP
 	r.POST("/api/v1alpha1/cluster/start", _ClusterInterface_Start0_HTTP_Handler(srv))
 	r.POST("/api/v1alpha1/cluster/stop", _ClusterInterface_Stop0_HTTP_Handler(srv))
 	r.GET("/api/v1alpha1/cluster/regions", _ClusterInterface_GetRegions0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/events", _ClusterInterface_ListEvents0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/provision/steps", _ClusterInterface_GetProvisionSteps0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/provision/step/retry", _ClusterInterface_RetryProvisionStep0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/provision/step/skip", _ClusterInterface_SkipProvisionStep0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/plan", _ClusterInterface_Plan0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/upgrade", _ClusterInterface_UpgradeCluster0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/etcd/policy", _ClusterInterface_GetEtcdBackupPolicy0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/etcd/policy", _ClusterInterface_SaveEtcdBackupPolicy0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/etcd/snapshots", _ClusterInterface_ListEtcdSnapshots0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/etcd/snapshot/verify", _ClusterInterface_VerifyEtcdSnapshot0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/etcd/snapshot/restore", _ClusterInterface_RestoreEtcdSnapshot0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/kind/image", _ClusterInterface_LoadKindImage0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/import", _ClusterInterface_ImportCluster0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/drift", _ClusterInterface_GetCloudDriftReport0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/drift/repair", _ClusterInterface_RepairCloudDrift0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/gc", _ClusterInterface_CollectOrphanedCloudResources0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/nodegroups", _ClusterInterface_ListNodeGroups0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/nodegroup", _ClusterInterface_SaveNodeGroup0_HTTP_Handler(srv))
+	r.DELETE("/api/v1alpha1/cluster/nodegroup", _ClusterInterface_DeleteNodeGroup0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/nodes", _ClusterInterface_ListNodes0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/node/systeminfo", _ClusterInterface_GetNodeSystemInfo0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/node/reboot", _ClusterInterface_RebootNode0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/node/reinit", _ClusterInterface_ReinitNode0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/node/replace", _ClusterInterface_ReplaceNode0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/node/retry", _ClusterInterface_RetryNode0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/spec", _ClusterInterface_ExportClusterSpec0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/spec/apply", _ClusterInterface_ApplyClusterSpec0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/node/hostkey/accept", _ClusterInterface_AcceptNodeHostKey0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/inventory", _ClusterInterface_GetInventory0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/inventory", _ClusterInterface_SaveInventory0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/preflight", _ClusterInterface_RunPreflight0_HTTP_Handler(srv))
+	r.GET("/api/v1alpha1/cluster/certificates", _ClusterInterface_ListCertificates0_HTTP_Handler(srv))
+	r.POST("/api/v1alpha1/cluster/certificates/rotate", _ClusterInterface_RotateCertificates0_HTTP_Handler(srv))
 }
 
 func _ClusterInterface_Ping0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
@@ -408,23 +540,743 @@ func _ClusterInterface_GetRegions0_HTTP_Handler(srv ClusterInterfaceHTTPServer)
 	}
 }
 
+func _ClusterInterface_ListEvents0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterEventListArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceListEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEvents(ctx, req.(*ClusterEventListArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClusterEventList)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_GetProvisionSteps0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceGetProvisionSteps)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProvisionSteps(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClusterProvisionSteps)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RetryProvisionStep0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterProvisionStepArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRetryProvisionStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryProvisionStep(ctx, req.(*ClusterProvisionStepArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_SkipProvisionStep0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterProvisionStepArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceSkipProvisionStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SkipProvisionStep(ctx, req.(*ClusterProvisionStepArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_Plan0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterPlanArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfacePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Plan(ctx, req.(*ClusterPlanArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClusterPlan)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_UpgradeCluster0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterUpgradeArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceUpgradeCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpgradeCluster(ctx, req.(*ClusterUpgradeArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_GetEtcdBackupPolicy0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceGetEtcdBackupPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEtcdBackupPolicy(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EtcdBackupPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_SaveEtcdBackupPolicy0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EtcdBackupPolicy
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceSaveEtcdBackupPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveEtcdBackupPolicy(ctx, req.(*EtcdBackupPolicy))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EtcdBackupPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ListEtcdSnapshots0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceListEtcdSnapshots)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEtcdSnapshots(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EtcdSnapshotList)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_VerifyEtcdSnapshot0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EtcdSnapshotArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceVerifyEtcdSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEtcdSnapshot(ctx, req.(*EtcdSnapshotArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EtcdSnapshot)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RestoreEtcdSnapshot0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EtcdSnapshotArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRestoreEtcdSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreEtcdSnapshot(ctx, req.(*EtcdSnapshotArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_LoadKindImage0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterLoadImageArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceLoadKindImage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoadKindImage(ctx, req.(*ClusterLoadImageArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ImportCluster0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterImportArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceImportCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportCluster(ctx, req.(*ClusterImportArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Cluster)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_GetCloudDriftReport0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloudDriftReportArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceGetCloudDriftReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCloudDriftReport(ctx, req.(*CloudDriftReportArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloudDriftReport)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RepairCloudDrift0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRepairCloudDrift)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RepairCloudDrift(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloudDriftReport)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_CollectOrphanedCloudResources0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OrphanedCloudResourceArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceCollectOrphanedCloudResources)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CollectOrphanedCloudResources(ctx, req.(*OrphanedCloudResourceArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrphanedCloudResources)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ListNodeGroups0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceListNodeGroups)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNodeGroups(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NodeGroups)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_SaveNodeGroup0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeGroupArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceSaveNodeGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveNodeGroup(ctx, req.(*NodeGroupArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NodeGroup)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_DeleteNodeGroup0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeGroupIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceDeleteNodeGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteNodeGroup(ctx, req.(*NodeGroupIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ListNodes0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeListArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceListNodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNodes(ctx, req.(*NodeListArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Nodes)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_GetNodeSystemInfo0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceGetNodeSystemInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNodeSystemInfo(ctx, req.(*NodeIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NodeSystemInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RebootNode0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRebootNode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RebootNode(ctx, req.(*NodeIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ReinitNode0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceReinitNode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReinitNode(ctx, req.(*NodeIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ReplaceNode0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceReplaceNode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplaceNode(ctx, req.(*NodeIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RetryNode0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRetryNode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryNode(ctx, req.(*NodeIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ExportClusterSpec0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterSpecExportArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceExportClusterSpec)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportClusterSpec(ctx, req.(*ClusterSpecExportArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClusterSpecFile)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ApplyClusterSpec0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterSpecApplyArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceApplyClusterSpec)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApplyClusterSpec(ctx, req.(*ClusterSpecApplyArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClusterPlan)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_AcceptNodeHostKey0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in NodeHostKeyArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceAcceptNodeHostKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptNodeHostKey(ctx, req.(*NodeHostKeyArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Node)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_GetInventory0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceGetInventory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetInventory(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Inventory)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_SaveInventory0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InventorySaveArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceSaveInventory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveInventory(ctx, req.(*InventorySaveArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Inventory)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RunPreflight0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRunPreflight)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RunPreflight(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreflightReport)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_ListCertificates0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CertificateListArgs
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceListCertificates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCertificates(ctx, req.(*CertificateListArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Certificates)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ClusterInterface_RotateCertificates0_HTTP_Handler(srv ClusterInterfaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterIdArgs
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationClusterInterfaceRotateCertificates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateCertificates(ctx, req.(*ClusterIdArgs))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*common.Msg)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ClusterInterfaceHTTPClient interface {
+	AcceptNodeHostKey(ctx context.Context, req *NodeHostKeyArgs, opts ...http.CallOption) (rsp *Node, err error)
+	ApplyClusterSpec(ctx context.Context, req *ClusterSpecApplyArgs, opts ...http.CallOption) (rsp *ClusterPlan, err error)
+	CollectOrphanedCloudResources(ctx context.Context, req *OrphanedCloudResourceArgs, opts ...http.CallOption) (rsp *OrphanedCloudResources, err error)
 	Delete(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	DeleteNodeGroup(ctx context.Context, req *NodeGroupIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	ExportClusterSpec(ctx context.Context, req *ClusterSpecExportArgs, opts ...http.CallOption) (rsp *ClusterSpecFile, err error)
 	Get(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *Cluster, err error)
+	GetCloudDriftReport(ctx context.Context, req *CloudDriftReportArgs, opts ...http.CallOption) (rsp *CloudDriftReport, err error)
 	GetClusterLevels(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ClusterLevels, err error)
 	GetClusterProviders(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ClusterProviders, err error)
 	GetClusterStatuses(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ClusterStatuses, err error)
 	GetClustersByIds(ctx context.Context, req *ClusterIdsArgs, opts ...http.CallOption) (rsp *ClusterList, err error)
+	GetEtcdBackupPolicy(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *EtcdBackupPolicy, err error)
+	GetInventory(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *Inventory, err error)
 	GetNodeGroupTypes(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *NodeGroupTypes, err error)
 	GetNodeRoles(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *NodeRoles, err error)
 	GetNodeStatuses(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *NodeStatuses, err error)
+	GetNodeSystemInfo(ctx context.Context, req *NodeIdArgs, opts ...http.CallOption) (rsp *NodeSystemInfo, err error)
+	GetProvisionSteps(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *ClusterProvisionSteps, err error)
 	GetRegions(ctx context.Context, req *ClusterRegionArgs, opts ...http.CallOption) (rsp *Regions, err error)
 	GetResourceTypes(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ResourceTypes, err error)
+	ImportCluster(ctx context.Context, req *ClusterImportArgs, opts ...http.CallOption) (rsp *Cluster, err error)
 	List(ctx context.Context, req *ClusterListArgs, opts ...http.CallOption) (rsp *ClusterList, err error)
+	ListCertificates(ctx context.Context, req *CertificateListArgs, opts ...http.CallOption) (rsp *Certificates, err error)
+	ListEtcdSnapshots(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *EtcdSnapshotList, err error)
+	ListEvents(ctx context.Context, req *ClusterEventListArgs, opts ...http.CallOption) (rsp *ClusterEventList, err error)
+	ListNodeGroups(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *NodeGroups, err error)
+	ListNodes(ctx context.Context, req *NodeListArgs, opts ...http.CallOption) (rsp *Nodes, err error)
+	LoadKindImage(ctx context.Context, req *ClusterLoadImageArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
 	Ping(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *common.Msg, err error)
+	Plan(ctx context.Context, req *ClusterPlanArgs, opts ...http.CallOption) (rsp *ClusterPlan, err error)
+	RebootNode(ctx context.Context, req *NodeIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	ReinitNode(ctx context.Context, req *NodeIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RepairCloudDrift(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *CloudDriftReport, err error)
+	ReplaceNode(ctx context.Context, req *NodeIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RestoreEtcdSnapshot(ctx context.Context, req *EtcdSnapshotArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RetryNode(ctx context.Context, req *NodeIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RetryProvisionStep(ctx context.Context, req *ClusterProvisionStepArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RotateCertificates(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	RunPreflight(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *PreflightReport, err error)
 	Save(ctx context.Context, req *ClusterSaveArgs, opts ...http.CallOption) (rsp *Cluster, err error)
+	SaveEtcdBackupPolicy(ctx context.Context, req *EtcdBackupPolicy, opts ...http.CallOption) (rsp *EtcdBackupPolicy, err error)
+	SaveInventory(ctx context.Context, req *InventorySaveArgs, opts ...http.CallOption) (rsp *Inventory, err error)
+	SaveNodeGroup(ctx context.Context, req *NodeGroupArgs, opts ...http.CallOption) (rsp *NodeGroup, err error)
+	SkipProvisionStep(ctx context.Context, req *ClusterProvisionStepArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
 	Start(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
 	Stop(ctx context.Context, req *ClusterIdArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	UpgradeCluster(ctx context.Context, req *ClusterUpgradeArgs, opts ...http.CallOption) (rsp *common.Msg, err error)
+	VerifyEtcdSnapshot(ctx context.Context, req *EtcdSnapshotArgs, opts ...http.CallOption) (rsp *EtcdSnapshot, err error)
 }
 
 type ClusterInterfaceHTTPClientImpl struct {
@@ -435,6 +1287,45 @@ func NewClusterInterfaceHTTPClient(client *http.Client) ClusterInterfaceHTTPClie
 	return &ClusterInterfaceHTTPClientImpl{client}
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) AcceptNodeHostKey(ctx context.Context, in *NodeHostKeyArgs, opts ...http.CallOption) (*Node, error) {
+	var out Node
+	pattern := "/api/v1alpha1/cluster/node/hostkey/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceAcceptNodeHostKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ApplyClusterSpec(ctx context.Context, in *ClusterSpecApplyArgs, opts ...http.CallOption) (*ClusterPlan, error) {
+	var out ClusterPlan
+	pattern := "/api/v1alpha1/cluster/spec/apply"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceApplyClusterSpec))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) CollectOrphanedCloudResources(ctx context.Context, in *OrphanedCloudResourceArgs, opts ...http.CallOption) (*OrphanedCloudResources, error) {
+	var out OrphanedCloudResources
+	pattern := "/api/v1alpha1/cluster/gc"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceCollectOrphanedCloudResources))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) Delete(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*common.Msg, error) {
 	var out common.Msg
 	pattern := "/api/v1alpha1/cluster"
@@ -448,11 +1339,50 @@ func (c *ClusterInterfaceHTTPClientImpl) Delete(ctx context.Context, in *Cluster
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) DeleteNodeGroup(ctx context.Context, in *NodeGroupIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/nodegroup"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceDeleteNodeGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ExportClusterSpec(ctx context.Context, in *ClusterSpecExportArgs, opts ...http.CallOption) (*ClusterSpecFile, error) {
+	var out ClusterSpecFile
+	pattern := "/api/v1alpha1/cluster/spec"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceExportClusterSpec))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) Get(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*Cluster, error) {
 	var out Cluster
 	pattern := "/api/v1alpha1/cluster"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationClusterInterfaceGet))
+	opts = append(opts, http.Operation(OperationClusterInterfaceGet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) GetCloudDriftReport(ctx context.Context, in *CloudDriftReportArgs, opts ...http.CallOption) (*CloudDriftReport, error) {
+	var out CloudDriftReport
+	pattern := "/api/v1alpha1/cluster/drift"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceGetCloudDriftReport))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -513,6 +1443,32 @@ func (c *ClusterInterfaceHTTPClientImpl) GetClustersByIds(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) GetEtcdBackupPolicy(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*EtcdBackupPolicy, error) {
+	var out EtcdBackupPolicy
+	pattern := "/api/v1alpha1/cluster/etcd/policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceGetEtcdBackupPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) GetInventory(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*Inventory, error) {
+	var out Inventory
+	pattern := "/api/v1alpha1/cluster/inventory"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceGetInventory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) GetNodeGroupTypes(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*NodeGroupTypes, error) {
 	var out NodeGroupTypes
 	pattern := "/api/v1alpha1/cluster/node/group/types"
@@ -552,6 +1508,32 @@ func (c *ClusterInterfaceHTTPClientImpl) GetNodeStatuses(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) GetNodeSystemInfo(ctx context.Context, in *NodeIdArgs, opts ...http.CallOption) (*NodeSystemInfo, error) {
+	var out NodeSystemInfo
+	pattern := "/api/v1alpha1/cluster/node/systeminfo"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceGetNodeSystemInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) GetProvisionSteps(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*ClusterProvisionSteps, error) {
+	var out ClusterProvisionSteps
+	pattern := "/api/v1alpha1/cluster/provision/steps"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceGetProvisionSteps))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) GetRegions(ctx context.Context, in *ClusterRegionArgs, opts ...http.CallOption) (*Regions, error) {
 	var out Regions
 	pattern := "/api/v1alpha1/cluster/regions"
@@ -578,6 +1560,19 @@ func (c *ClusterInterfaceHTTPClientImpl) GetResourceTypes(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) ImportCluster(ctx context.Context, in *ClusterImportArgs, opts ...http.CallOption) (*Cluster, error) {
+	var out Cluster
+	pattern := "/api/v1alpha1/cluster/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceImportCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) List(ctx context.Context, in *ClusterListArgs, opts ...http.CallOption) (*ClusterList, error) {
 	var out ClusterList
 	pattern := "/api/v1alpha1/cluster/list"
@@ -591,6 +1586,84 @@ func (c *ClusterInterfaceHTTPClientImpl) List(ctx context.Context, in *ClusterLi
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) ListCertificates(ctx context.Context, in *CertificateListArgs, opts ...http.CallOption) (*Certificates, error) {
+	var out Certificates
+	pattern := "/api/v1alpha1/cluster/certificates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceListCertificates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ListEtcdSnapshots(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*EtcdSnapshotList, error) {
+	var out EtcdSnapshotList
+	pattern := "/api/v1alpha1/cluster/etcd/snapshots"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceListEtcdSnapshots))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ListEvents(ctx context.Context, in *ClusterEventListArgs, opts ...http.CallOption) (*ClusterEventList, error) {
+	var out ClusterEventList
+	pattern := "/api/v1alpha1/cluster/events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceListEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ListNodeGroups(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*NodeGroups, error) {
+	var out NodeGroups
+	pattern := "/api/v1alpha1/cluster/nodegroups"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceListNodeGroups))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ListNodes(ctx context.Context, in *NodeListArgs, opts ...http.CallOption) (*Nodes, error) {
+	var out Nodes
+	pattern := "/api/v1alpha1/cluster/nodes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfaceListNodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) LoadKindImage(ctx context.Context, in *ClusterLoadImageArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/kind/image"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceLoadKindImage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) Ping(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*common.Msg, error) {
 	var out common.Msg
 	pattern := "/api/v1alpha1/cluster/ping"
@@ -604,6 +1677,136 @@ func (c *ClusterInterfaceHTTPClientImpl) Ping(ctx context.Context, in *emptypb.E
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) Plan(ctx context.Context, in *ClusterPlanArgs, opts ...http.CallOption) (*ClusterPlan, error) {
+	var out ClusterPlan
+	pattern := "/api/v1alpha1/cluster/plan"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationClusterInterfacePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RebootNode(ctx context.Context, in *NodeIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/node/reboot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRebootNode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ReinitNode(ctx context.Context, in *NodeIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/node/reinit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceReinitNode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RepairCloudDrift(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*CloudDriftReport, error) {
+	var out CloudDriftReport
+	pattern := "/api/v1alpha1/cluster/drift/repair"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRepairCloudDrift))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) ReplaceNode(ctx context.Context, in *NodeIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/node/replace"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceReplaceNode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RestoreEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/etcd/snapshot/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRestoreEtcdSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RetryNode(ctx context.Context, in *NodeIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/node/retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRetryNode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RetryProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/provision/step/retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRetryProvisionStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RotateCertificates(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/certificates/rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRotateCertificates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) RunPreflight(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*PreflightReport, error) {
+	var out PreflightReport
+	pattern := "/api/v1alpha1/cluster/preflight"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceRunPreflight))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) Save(ctx context.Context, in *ClusterSaveArgs, opts ...http.CallOption) (*Cluster, error) {
 	var out Cluster
 	pattern := "/api/v1alpha1/cluster"
@@ -617,6 +1820,58 @@ func (c *ClusterInterfaceHTTPClientImpl) Save(ctx context.Context, in *ClusterSa
 	return &out, nil
 }
 
+func (c *ClusterInterfaceHTTPClientImpl) SaveEtcdBackupPolicy(ctx context.Context, in *EtcdBackupPolicy, opts ...http.CallOption) (*EtcdBackupPolicy, error) {
+	var out EtcdBackupPolicy
+	pattern := "/api/v1alpha1/cluster/etcd/policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceSaveEtcdBackupPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) SaveInventory(ctx context.Context, in *InventorySaveArgs, opts ...http.CallOption) (*Inventory, error) {
+	var out Inventory
+	pattern := "/api/v1alpha1/cluster/inventory"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceSaveInventory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) SaveNodeGroup(ctx context.Context, in *NodeGroupArgs, opts ...http.CallOption) (*NodeGroup, error) {
+	var out NodeGroup
+	pattern := "/api/v1alpha1/cluster/nodegroup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceSaveNodeGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) SkipProvisionStep(ctx context.Context, in *ClusterProvisionStepArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/provision/step/skip"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceSkipProvisionStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ClusterInterfaceHTTPClientImpl) Start(ctx context.Context, in *ClusterIdArgs, opts ...http.CallOption) (*common.Msg, error) {
 	var out common.Msg
 	pattern := "/api/v1alpha1/cluster/start"
@@ -642,3 +1897,29 @@ func (c *ClusterInterfaceHTTPClientImpl) Stop(ctx context.Context, in *ClusterId
 	}
 	return &out, nil
 }
+
+func (c *ClusterInterfaceHTTPClientImpl) UpgradeCluster(ctx context.Context, in *ClusterUpgradeArgs, opts ...http.CallOption) (*common.Msg, error) {
+	var out common.Msg
+	pattern := "/api/v1alpha1/cluster/upgrade"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceUpgradeCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ClusterInterfaceHTTPClientImpl) VerifyEtcdSnapshot(ctx context.Context, in *EtcdSnapshotArgs, opts ...http.CallOption) (*EtcdSnapshot, error) {
+	var out EtcdSnapshot
+	pattern := "/api/v1alpha1/cluster/etcd/snapshot/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationClusterInterfaceVerifyEtcdSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
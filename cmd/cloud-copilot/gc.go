@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/f-rambo/cloud-copilot/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// runCloudResourceGC lists the orphaned cloud resources and deletes them once confirmed,
+// eg: cloud-copilot -conf configs gc -provider aws -access-id xxx -access-key xxx -region us-east-1
+func runCloudResourceGC(ctx context.Context, bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	flagSet := flag.NewFlagSet("gc", flag.ExitOnError)
+	provider := flagSet.String("provider", "", "cloud provider, aws or ali_cloud")
+	accessId := flagSet.String("access-id", os.Getenv("CLOUD_ACCESS_ID"), "cloud access id, defaults to $CLOUD_ACCESS_ID")
+	accessKey := flagSet.String("access-key", os.Getenv("CLOUD_ACCESS_KEY"), "cloud access key, defaults to $CLOUD_ACCESS_KEY")
+	regions := flagSet.String("region", "", "comma separated regions, every region of the account when empty")
+	yes := flagSet.Bool("yes", false, "delete without asking for confirmation")
+	err := flagSet.Parse(args)
+	if err != nil {
+		return err
+	}
+	gcArgs := &biz.CloudResourceGCArgs{
+		Provider:  biz.ClusterProviderFromString(*provider),
+		AccessId:  *accessId,
+		AccessKey: *accessKey,
+	}
+	for _, region := range strings.Split(*regions, ",") {
+		if strings.TrimSpace(region) != "" {
+			gcArgs.Regions = append(gcArgs.Regions, strings.TrimSpace(region))
+		}
+	}
+
+	gcUc, cleanup, err := wireCloudResourceGC(ctx, bc, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	result, err := gcUc.CollectOrphanedCloudResources(ctx, gcArgs)
+	if err != nil {
+		return err
+	}
+	printOrphanedCloudResources(result)
+	if len(result.Resources) == 0 {
+		return nil
+	}
+	if !*yes {
+		fmt.Printf("delete %d orphaned cloud resources? [y/N] ", len(result.Resources))
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if strings.ToLower(strings.TrimSpace(answer)) != "y" {
+			return nil
+		}
+	}
+	// only the listed resources are deleted, the ones tagged or adopted since are left for the next review
+	gcArgs.Confirm = true
+	for _, orphan := range result.Resources {
+		gcArgs.ResourceIds = append(gcArgs.ResourceIds, orphan.Resource.RefId)
+	}
+	result, err = gcUc.CollectOrphanedCloudResources(ctx, gcArgs)
+	if err != nil {
+		return err
+	}
+	printOrphanedCloudResources(result)
+	for _, orphan := range result.Resources {
+		if orphan.Error != "" && orphan.Error != biz.OrphanedErrorNotOrphaned {
+			return errors.New("some orphaned cloud resources were not deleted")
+		}
+	}
+	return nil
+}
+
+func printOrphanedCloudResources(result *biz.CloudResourceGCResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REGION\tCLUSTER\tTYPE\tID\tNAME\tREASON\tMONTHLY COST\tSTATUS")
+	for _, orphan := range result.Resources {
+		status := "orphaned"
+		if orphan.Deleted {
+			status = "deleted"
+		}
+		if orphan.Error != "" {
+			status = orphan.Error
+		}
+		fmt.Fprintf(w, "%s\t%d/%s\t%s\t%s\t%s\t%s\t$%.2f\t%s\n", orphan.Region, orphan.ClusterId, orphan.ClusterName,
+			orphan.Resource.Type.String(), orphan.Resource.RefId, orphan.Resource.Name, orphan.Reason, orphan.MonthlyCost, status)
+	}
+	fmt.Fprintf(w, "TOTAL\t\t\t\t\t\t$%.2f\t\n", result.MonthlyCost)
+	w.Flush()
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -107,6 +108,24 @@ func main() {
 
 	log.SetLogger(logger)
 
+	// gc command
+	if flag.Arg(0) == "gc" {
+		if err := runCloudResourceGC(context.Background(), &bc, logger, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// rotate-keys command
+	if flag.Arg(0) == "rotate-keys" {
+		if err := runRotateSecrets(context.Background(), &bc, logger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// app
 	app, cleanup, err := wireApp(
 		context.Background(),
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/f-rambo/cloud-copilot/internal/conf"
+	"github.com/f-rambo/cloud-copilot/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// runRotateSecrets seals the stored secrets with the current key of the key file, run it after pointing
+// current at a new key and keep the old key in the file until it finished,
+// eg: cloud-copilot -conf configs rotate-keys
+func runRotateSecrets(ctx context.Context, bc *conf.Bootstrap, logger log.Logger) error {
+	dataData, cleanup, err := data.NewData(ctx, bc, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	rotated, err := dataData.RotateSecrets(ctx)
+	fmt.Printf("re-encrypted %d rows\n", rotated)
+	return err
+}
@@ -24,3 +24,8 @@ import (
 func wireApp(context.Context, *conf.Bootstrap, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(data.ProviderSet, infrastructure.ProviderSet, runtime.ProviderSet, biz.ProviderSet, interfaces.ProviderSet, server.ProviderSet, newApp))
 }
+
+// wireCloudResourceGC init the cloud resource garbage collector of the gc command.
+func wireCloudResourceGC(context.Context, *conf.Bootstrap, log.Logger) (*biz.CloudResourceGCUsecase, func(), error) {
+	panic(wire.Build(data.ProviderSet, infrastructure.ProviderSet, biz.NewCloudResourceGCUsecase))
+}
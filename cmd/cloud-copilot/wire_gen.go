@@ -36,31 +36,34 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, logger l
 	baremetal := infrastructure.NewBaremetal(bootstrap, logger)
 	aliCloudUsecase := infrastructure.NewAliCloudUseCase(bootstrap, logger)
 	awsCloudUsecase := infrastructure.NewAwsCloudUseCase(bootstrap, logger)
-	clusterInfrastructure := infrastructure.NewInfrastructure(bootstrap, baremetal, aliCloudUsecase, awsCloudUsecase, logger)
-	clusterRuntime := runtime.NewClusterRuntime(bootstrap, logger)
+	kind := infrastructure.NewKind(bootstrap, logger)
+	clusterInfrastructure := infrastructure.NewInfrastructure(bootstrap, baremetal, aliCloudUsecase, awsCloudUsecase, kind, logger)
+	clusterClients := runtime.NewClusterClients(clusterData, logger)
+	clusterRuntime := runtime.NewClusterRuntime(bootstrap, clusterClients, logger)
 	clusterUsecase, err := biz.NewClusterUseCase(contextContext, bootstrap, clusterData, clusterInfrastructure, clusterRuntime, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	clusterInterface := interfaces.NewClusterInterface(clusterUsecase, bootstrap, logger)
+	cloudResourceGCUsecase := biz.NewCloudResourceGCUsecase(clusterData, clusterInfrastructure, logger)
+	clusterInterface := interfaces.NewClusterInterface(clusterUsecase, cloudResourceGCUsecase, bootstrap, logger)
 	appData := data.NewAppRepo(dataData, logger)
-	appRuntime := runtime.NewAppRuntime(logger)
+	appRuntime := runtime.NewAppRuntime(clusterClients, logger)
 	appUsecase := biz.NewAppUsecase(appData, appRuntime, logger, bootstrap)
 	userData := data.NewUserRepo(dataData, bootstrap, logger)
 	userUseCase := biz.NewUseUser(userData, logger, bootstrap)
 	appInterface := interfaces.NewAppInterface(appUsecase, userUseCase, logger)
 	servicesData := data.NewServicesRepo(dataData, logger)
-	serviceRuntime := runtime.NewServiceRuntime(logger)
-	workflowRuntime := runtime.NewWorkflowRuntime(logger)
-	servicesUseCase := biz.NewServicesUseCase(servicesData, serviceRuntime, workflowRuntime, logger)
+	serviceRuntime := runtime.NewServiceRuntime(clusterClients, logger)
+	workflowRuntime := runtime.NewWorkflowRuntime(clusterClients, logger)
+	servicesUseCase := biz.NewServicesUseCase(servicesData, clusterData, serviceRuntime, workflowRuntime, logger)
 	servicesInterface := interfaces.NewServicesInterface(servicesUseCase)
 	userInterface := interfaces.NewUserInterface(userUseCase, bootstrap)
 	workspaceData := data.NewWorkspaceRepo(dataData, logger)
 	workspaceUsecase := biz.NewWorkspaceUsecase(workspaceData, logger)
 	workspaceInterface := interfaces.NewWorkspaceInterface(workspaceUsecase, logger)
 	projectData := data.NewProjectRepo(dataData, bootstrap, logger)
-	projectRuntime := runtime.NewProjectRuntime(logger)
+	projectRuntime := runtime.NewProjectRuntime(clusterClients, logger)
 	projectUsecase := biz.NewProjectUseCase(projectData, projectRuntime, logger, bootstrap)
 	projectInterface := interfaces.NewProjectInterface(projectUsecase, userUseCase, bootstrap, logger)
 	grpcServer := server.NewGRPCServer(bootstrap, clusterInterface, appInterface, servicesInterface, userInterface, workspaceInterface, projectInterface, logger)
@@ -75,3 +78,21 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, logger l
 		cleanup()
 	}, nil
 }
+
+// wireCloudResourceGC init the cloud resource garbage collector of the gc command.
+func wireCloudResourceGC(contextContext context.Context, bootstrap *conf.Bootstrap, logger log.Logger) (*biz.CloudResourceGCUsecase, func(), error) {
+	dataData, cleanup, err := data.NewData(contextContext, bootstrap, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterData := data.NewClusterRepo(dataData, logger)
+	baremetal := infrastructure.NewBaremetal(bootstrap, logger)
+	aliCloudUsecase := infrastructure.NewAliCloudUseCase(bootstrap, logger)
+	awsCloudUsecase := infrastructure.NewAwsCloudUseCase(bootstrap, logger)
+	kind := infrastructure.NewKind(bootstrap, logger)
+	clusterInfrastructure := infrastructure.NewInfrastructure(bootstrap, baremetal, aliCloudUsecase, awsCloudUsecase, kind, logger)
+	cloudResourceGCUsecase := biz.NewCloudResourceGCUsecase(clusterData, clusterInfrastructure, logger)
+	return cloudResourceGCUsecase, func() {
+		cleanup()
+	}, nil
+}
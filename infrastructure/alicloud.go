@@ -33,6 +33,15 @@ const (
 	ALICLOUD_DEFAULT_REGION = "ALICLOUD_DEFAULT_REGION"
 )
 
+// aliVpcTagResourceTypes are the TagResources resource types of the vpc api
+var aliVpcTagResourceTypes = map[biz.ResourceType]string{
+	biz.ResourceType_VPC:         "VPC",
+	biz.ResourceType_SUBNET:      "VSWITCH",
+	biz.ResourceType_ELASTIC_IP:  "EIP",
+	biz.ResourceType_NAT_GATEWAY: "NATGATEWAY",
+	biz.ResourceType_ROUTE_TABLE: "ROUTETABLE",
+}
+
 type AliCloudUsecase struct {
 	c         *conf.Bootstrap
 	log       *log.Helper
@@ -239,7 +248,9 @@ func (a *AliCloudUsecase) ImportKeyPair(ctx context.Context, cluster *biz.Cluste
 	}
 
 	// Add tags to key pair
-	err = a.createEcsTag(cluster.Region, tea.StringValue(importRes.Body.KeyPairName), "keypair", map[biz.ResourceTypeKeyValue]any{biz.ResourceTypeKeyValue_NAME: keyPairName})
+	tags := cluster.GetTags()
+	tags[biz.ResourceTypeKeyValue_NAME] = keyPairName
+	err = a.createEcsTag(cluster.Region, tea.StringValue(importRes.Body.KeyPairName), "keypair", tags)
 	if err != nil {
 		return errors.Wrap(err, "failed to tag key pair")
 	}
@@ -249,7 +260,7 @@ func (a *AliCloudUsecase) ImportKeyPair(ctx context.Context, cluster *biz.Cluste
 		Name:  keyPairName,
 		RefId: tea.StringValue(importRes.Body.KeyPairName),
 		Type:  biz.ResourceType_KEY_PAIR,
-		Tags:  cluster.EncodeTags(map[biz.ResourceTypeKeyValue]any{biz.ResourceTypeKeyValue_NAME: keyPairName}),
+		Tags:  cluster.EncodeTags(tags),
 	})
 
 	a.log.Infof("key pair %s imported successfully", keyPairName)
@@ -413,16 +424,17 @@ func (a *AliCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 			}
 			privateSubnetTagsMap := cluster.DecodeTags(privateSubnet.Tags)
 			zoneId := cast.ToString(privateSubnetTagsMap[biz.ResourceTypeKeyValue_ZONE_ID])
-			// check instance inventory
+			// check instance inventory, a node that can not be created is parked in error and the others go on
 			ok, err := a.checkingInstanceInventory(cluster.Region, zoneId, node.InstanceType)
 			if err != nil {
-				return err
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, err)
+				continue
 			}
 			if !ok {
 				for _, instanceId := range strings.Split(node.BackupInstanceIds, ",") {
 					ok, err = a.checkingInstanceInventory(cluster.Region, zoneId, instanceId)
 					if err != nil {
-						return err
+						break
 					}
 					if ok {
 						node.InstanceId = instanceId
@@ -430,9 +442,12 @@ func (a *AliCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 					}
 				}
 			}
+			if err != nil {
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, err)
+				continue
+			}
 			if !ok {
-				node.ErrorType = biz.NodeErrorType_INFRASTRUCTURE_ERROR
-				node.ErrorMessage = "INSUFFICIENT INVENTORY"
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, errors.Errorf("insufficient inventory of %s in zone %s", node.InstanceType, zoneId))
 				continue
 			}
 			createInstanceRequest := &ecs.CreateInstanceRequest{
@@ -457,11 +472,12 @@ func (a *AliCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 			}
 			createInstanceRes, err := a.ecsClient.CreateInstance(createInstanceRequest)
 			if err != nil {
-				node.ErrorType = biz.NodeErrorType_INFRASTRUCTURE_ERROR
-				node.ErrorMessage = "CREATE FAILURE"
-				return errors.Wrap(err, "failed to create instance")
+				a.log.Errorf("create instance of node %s failed: %v", node.Name, err)
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, errors.Wrap(err, "failed to create instance"))
+				continue
 			}
 			node.InstanceId = tea.StringValue(createInstanceRes.Body.InstanceId)
+			node.HostKey = ""
 			if nodeGroup.NodePrice < tea.Float32Value(createInstanceRes.Body.TradePrice) {
 				nodeGroup.NodePrice = tea.Float32Value(createInstanceRes.Body.TradePrice)
 			}
@@ -550,9 +566,33 @@ func (a *AliCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 		node.Username = "root"
 		time.Sleep(time.Second)
 	}
+	a.seedHostKeys(cluster)
 	return nil
 }
 
+// seedHostKeys pins the host keys cloud-init printed to the console of the new instances,
+// the console output may not be ready yet and the key is then trusted on the first ssh connection
+func (a *AliCloudUsecase) seedHostKeys(cluster *biz.Cluster) {
+	for _, node := range cluster.Nodes {
+		if node.InstanceId == "" || node.HostKey != "" {
+			continue
+		}
+		output, err := a.ecsClient.GetInstanceConsoleOutput(&ecs.GetInstanceConsoleOutputRequest{
+			RegionId:   tea.String(cluster.Region),
+			InstanceId: tea.String(node.InstanceId),
+		})
+		if err != nil {
+			a.log.Warnf("get console output of %s failed: %v", node.InstanceId, err)
+			continue
+		}
+		consoleOutput, err := base64.StdEncoding.DecodeString(tea.StringValue(output.Body.ConsoleOutput))
+		if err != nil {
+			continue
+		}
+		node.HostKey = getConsoleHostKey(string(consoleOutput))
+	}
+}
+
 func (a *AliCloudUsecase) DeleteNetwork(ctx context.Context, cluster *biz.Cluster) error {
 	// Delete SLB
 	for _, v := range cluster.GetCloudResource(biz.ResourceType_LOAD_BALANCER) {
@@ -1283,17 +1323,21 @@ func (a *AliCloudUsecase) createNatGateways(ctx context.Context, cluster *biz.Cl
 		if err != nil {
 			return errors.Wrap(err, "failed to create nat gateway snat")
 		}
+		natGatewayTags := cluster.GetTags()
+		natGatewayTags[biz.ResourceTypeKeyValue_NAME] = natGatewayName
+		natGatewayTags[biz.ResourceTypeKeyValue_ACCESS] = biz.ResourceTypeKeyValue_ACCESS_PUBLIC
+		natGatewayTags[biz.ResourceTypeKeyValue_ZONE_ID] = az.RefId
+		err = a.createVpcTags(cluster.Region, tea.StringValue(natRes.Body.NatGatewayId), "NATGATEWAY", natGatewayTags)
+		if err != nil {
+			return errors.Wrap(err, "failed to tag nat gateway")
+		}
 		cluster.AddCloudResource(&biz.CloudResource{
 			Name:         natGatewayName,
 			RefId:        tea.StringValue(natRes.Body.NatGatewayId),
 			Type:         biz.ResourceType_NAT_GATEWAY,
 			AssociatedId: privateSubnet.RefId,
 			Value:        eip.RefId,
-			Tags: cluster.EncodeTags(map[biz.ResourceTypeKeyValue]any{
-				biz.ResourceTypeKeyValue_NAME:    natGatewayName,
-				biz.ResourceTypeKeyValue_ACCESS:  biz.ResourceTypeKeyValue_ACCESS_PUBLIC,
-				biz.ResourceTypeKeyValue_ZONE_ID: az.RefId,
-			}),
+			Tags:         cluster.EncodeTags(natGatewayTags),
 		})
 	}
 
@@ -1358,6 +1402,10 @@ func (a *AliCloudUsecase) createRouteTables(ctx context.Context, cluster *biz.Cl
 		if err != nil {
 			return errors.Wrap(err, "failed to create private route table for AZ "+az.RefId)
 		}
+		err = a.createVpcTags(cluster.Region, tea.StringValue(privateRouteTableRes.Body.RouteTableId), "ROUTETABLE", tags)
+		if err != nil {
+			return errors.Wrap(err, "failed to tag route table")
+		}
 		cluster.AddCloudResource(&biz.CloudResource{
 			Name:  routeTableName,
 			RefId: tea.StringValue(privateRouteTableRes.Body.RouteTableId),
@@ -1531,6 +1579,10 @@ func (a *AliCloudUsecase) ManageSecurityGroup(ctx context.Context, cluster *biz.
 		if CreateSecurityGroupErr != nil {
 			return errors.Wrap(CreateSecurityGroupErr, "failed to create security group")
 		}
+		err = a.createEcsTag(cluster.Region, tea.StringValue(sgRes.Body.SecurityGroupId), "securitygroup", tags)
+		if err != nil {
+			return errors.Wrap(err, "failed to tag security group")
+		}
 		sgCloudResource = &biz.CloudResource{
 			Name:         sgName,
 			RefId:        tea.StringValue(sgRes.Body.SecurityGroupId),
@@ -1658,9 +1710,16 @@ func (a *AliCloudUsecase) ManageSLB(_ context.Context, cluster *biz.Cluster) err
 		}
 
 		a.log.Infof("slb %s created", tea.StringValue(slbRes.Body.LoadBalancerName))
+		slbTags := cluster.GetTags()
+		slbTags[biz.ResourceTypeKeyValue_NAME] = slbName
+		err = a.createSlbTags(cluster.Region, tea.StringValue(slbRes.Body.LoadBalancerId), slbTags)
+		if err != nil {
+			return errors.Wrap(err, "failed to tag slb")
+		}
 		cluster.AddCloudResource(&biz.CloudResource{
 			Name:  slbName,
 			RefId: tea.StringValue(slbRes.Body.LoadBalancerId),
+			Tags:  cluster.EncodeTags(slbTags),
 			Type:  biz.ResourceType_LOAD_BALANCER,
 			Value: tea.StringValue(slbRes.Body.Address),
 		})
@@ -1797,6 +1856,347 @@ func (a *AliCloudUsecase) ManageSLB(_ context.Context, cluster *biz.Cluster) err
 	return nil
 }
 
+// DetectCloudDrift compares the stored network, security group, key pair and load balancer of the cluster with the live state
+func (a *AliCloudUsecase) DetectCloudDrift(ctx context.Context, cluster *biz.Cluster) ([]*biz.CloudDrift, error) {
+	vpcRes := cluster.GetSingleCloudResource(biz.ResourceType_VPC)
+	if vpcRes == nil {
+		return nil, errors.New("vpc not found")
+	}
+	detector := newCloudDriftDetector(cluster)
+
+	vpcsRes, err := a.vpcClient.DescribeVpcs(&vpc.DescribeVpcsRequest{
+		RegionId:   tea.String(cluster.Region),
+		VpcId:      tea.String(vpcRes.RefId),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(10),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe VPCs")
+	}
+	lives := make([]*liveCloudResource, 0)
+	for _, v := range vpcsRes.Body.Vpcs.Vpc {
+		live := &liveCloudResource{refId: tea.StringValue(v.VpcId), tags: aliTagValues(v.Tags)}
+		if tea.StringValue(v.CidrBlock) != cluster.VpcCidr {
+			live.details = append(live.details, fmt.Sprintf("cidr is %s, expected %s", tea.StringValue(v.CidrBlock), cluster.VpcCidr))
+		}
+		lives = append(lives, live)
+	}
+	detector.compareResources(biz.ResourceType_VPC, lives, false)
+
+	subnetRes, err := a.vpcClient.DescribeVSwitches(&vpc.DescribeVSwitchesRequest{
+		RegionId:   tea.String(cluster.Region),
+		VpcId:      tea.String(vpcRes.RefId),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(50),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe vswitches")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, subnet := range subnetRes.Body.VSwitches.VSwitch {
+		live := &liveCloudResource{refId: tea.StringValue(subnet.VSwitchId), name: tea.StringValue(subnet.VSwitchName), tags: aliTagValues(subnet.Tags)}
+		stored := cluster.GetCloudResourceByRefID(biz.ResourceType_SUBNET, live.refId)
+		if stored != nil && stored.Value != "" && stored.Value != tea.StringValue(subnet.CidrBlock) {
+			live.details = append(live.details, fmt.Sprintf("cidr is %s, expected %s", tea.StringValue(subnet.CidrBlock), stored.Value))
+		}
+		lives = append(lives, live)
+	}
+	detector.compareResources(biz.ResourceType_SUBNET, lives, true)
+
+	lives = make([]*liveCloudResource, 0)
+	eipIds := make([]string, 0)
+	for _, eip := range cluster.GetCloudResource(biz.ResourceType_ELASTIC_IP) {
+		eipIds = append(eipIds, eip.RefId)
+	}
+	if len(eipIds) != 0 {
+		eipRes, err := a.vpcClient.DescribeEipAddresses(&vpc.DescribeEipAddressesRequest{
+			RegionId:     tea.String(cluster.Region),
+			AllocationId: tea.String(strings.Join(eipIds, ",")),
+			PageNumber:   tea.Int32(1),
+			PageSize:     tea.Int32(100),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe eip addresses")
+		}
+		if eipRes.Body.EipAddresses != nil {
+			for _, eip := range eipRes.Body.EipAddresses.EipAddress {
+				lives = append(lives, &liveCloudResource{refId: tea.StringValue(eip.AllocationId), tags: aliTagValues(eip.Tags)})
+			}
+		}
+	}
+	detector.compareResources(biz.ResourceType_ELASTIC_IP, lives, false)
+
+	natGatewayRes, err := a.vpcClient.DescribeNatGateways(&vpc.DescribeNatGatewaysRequest{
+		RegionId:   tea.String(cluster.Region),
+		VpcId:      tea.String(vpcRes.RefId),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(50),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nat gateways")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, natGateway := range natGatewayRes.Body.NatGateways.NatGateway {
+		lives = append(lives, &liveCloudResource{refId: tea.StringValue(natGateway.NatGatewayId), name: tea.StringValue(natGateway.Name), tags: aliTagValues(natGateway.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_NAT_GATEWAY, lives, true)
+
+	routeTableRes, err := a.vpcClient.DescribeRouteTableList(&vpc.DescribeRouteTableListRequest{
+		RegionId:   tea.String(cluster.Region),
+		VpcId:      tea.String(vpcRes.RefId),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(50),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe route tables")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, routeTable := range routeTableRes.Body.RouterTableList.RouterTableListType {
+		// the system route table comes with the vpc
+		if tea.StringValue(routeTable.RouteTableType) == "System" {
+			continue
+		}
+		lives = append(lives, &liveCloudResource{refId: tea.StringValue(routeTable.RouteTableId), name: tea.StringValue(routeTable.RouteTableName), tags: aliTagValues(routeTable.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_ROUTE_TABLE, lives, true)
+
+	securityGroupsRes, err := a.ecsClient.DescribeSecurityGroups(&ecs.DescribeSecurityGroupsRequest{
+		RegionId:   tea.String(cluster.Region),
+		VpcId:      tea.String(vpcRes.RefId),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(50),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe security groups")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, securityGroup := range securityGroupsRes.Body.SecurityGroups.SecurityGroup {
+		lives = append(lives, &liveCloudResource{
+			refId: tea.StringValue(securityGroup.SecurityGroupId),
+			name:  tea.StringValue(securityGroup.SecurityGroupName),
+			tags:  aliTagValues(securityGroup.Tags),
+		})
+	}
+	detector.compareResources(biz.ResourceType_SECURITY_GROUP, lives, true)
+	for _, securityGroup := range cluster.GetCloudResource(biz.ResourceType_SECURITY_GROUP) {
+		if !slices.ContainsFunc(lives, func(live *liveCloudResource) bool { return live.refId == securityGroup.RefId }) {
+			continue
+		}
+		sgRuleRes, err := a.ecsClient.DescribeSecurityGroupAttribute(&ecs.DescribeSecurityGroupAttributeRequest{
+			RegionId:        tea.String(cluster.Region),
+			SecurityGroupId: tea.String(securityGroup.RefId),
+			Direction:       tea.String("ingress"),
+			MaxResults:      tea.Int32(1000),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe security group attribute")
+		}
+		ruleKeys := make([]string, 0)
+		for _, sgRule := range sgRuleRes.Body.Permissions.Permission {
+			var startPort, endPort int32
+			fmt.Sscanf(tea.StringValue(sgRule.PortRange), "%d/%d", &startPort, &endPort)
+			ruleKeys = append(ruleKeys, biz.SecurityRuleKey(tea.StringValue(sgRule.IpProtocol), tea.StringValue(sgRule.SourceCidrIp), startPort, endPort))
+		}
+		detector.compareSecurityRules(securityGroup, ruleKeys)
+	}
+
+	lives = make([]*liveCloudResource, 0)
+	for _, keyPair := range cluster.GetCloudResource(biz.ResourceType_KEY_PAIR) {
+		keyPairs, err := a.ecsClient.DescribeKeyPairs(&ecs.DescribeKeyPairsRequest{
+			RegionId:    tea.String(cluster.Region),
+			KeyPairName: tea.String(keyPair.RefId),
+			PageNumber:  tea.Int32(1),
+			PageSize:    tea.Int32(50),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe key pairs")
+		}
+		for _, kp := range keyPairs.Body.KeyPairs.KeyPair {
+			if tea.StringValue(kp.KeyPairName) == keyPair.RefId {
+				lives = append(lives, &liveCloudResource{refId: tea.StringValue(kp.KeyPairName), tags: aliTagValues(kp.Tags)})
+			}
+		}
+	}
+	detector.compareResources(biz.ResourceType_KEY_PAIR, lives, false)
+
+	lives = make([]*liveCloudResource, 0)
+	slbIds := make([]string, 0)
+	for _, slbResource := range cluster.GetCloudResource(biz.ResourceType_LOAD_BALANCER) {
+		slbIds = append(slbIds, slbResource.RefId)
+	}
+	if len(slbIds) != 0 {
+		loadBalancers, err := a.slbClient.DescribeLoadBalancers(&slb.DescribeLoadBalancersRequest{
+			RegionId:       tea.String(cluster.Region),
+			LoadBalancerId: tea.String(strings.Join(slbIds, ",")),
+			PageNumber:     tea.Int32(1),
+			PageSize:       tea.Int32(50),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe load balancers")
+		}
+		for _, lb := range loadBalancers.Body.LoadBalancers.LoadBalancer {
+			lives = append(lives, &liveCloudResource{refId: tea.StringValue(lb.LoadBalancerId), tags: aliTagValues(lb.Tags)})
+		}
+	}
+	detector.compareResources(biz.ResourceType_LOAD_BALANCER, lives, false)
+	return detector.drifts, nil
+}
+
+// RepairCloudDrift tags modified resources again, forgets missing ones so the network, key pair,
+// security group and load balancer steps create them again, and sets the security rules back to the cluster rules
+func (a *AliCloudUsecase) RepairCloudDrift(ctx context.Context, cluster *biz.Cluster, drifts []*biz.CloudDrift) error {
+	slbMissing := false
+	for _, resourceType := range driftResourceTypes {
+		for _, resource := range driftResources(cluster, drifts, resourceType, biz.CloudDriftType_MODIFIED) {
+			var err error
+			switch resourceType {
+			case biz.ResourceType_VPC, biz.ResourceType_SUBNET, biz.ResourceType_ELASTIC_IP, biz.ResourceType_NAT_GATEWAY, biz.ResourceType_ROUTE_TABLE:
+				err = a.createVpcTags(cluster.Region, resource.RefId, aliVpcTagResourceTypes[resourceType], cluster.CloudTags(resource))
+			case biz.ResourceType_SECURITY_GROUP:
+				err = a.createEcsTag(cluster.Region, resource.RefId, "securitygroup", cluster.CloudTags(resource))
+			case biz.ResourceType_KEY_PAIR:
+				err = a.createEcsTag(cluster.Region, resource.RefId, "keypair", cluster.CloudTags(resource))
+			case biz.ResourceType_LOAD_BALANCER:
+				err = a.createSlbTags(cluster.Region, resource.RefId, cluster.CloudTags(resource))
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to tag %s %s", resourceType.String(), resource.RefId)
+			}
+			a.log.Infof("%s %s tagged again", resourceType.String(), resource.RefId)
+		}
+		for _, resource := range driftResources(cluster, drifts, resourceType, biz.CloudDriftType_MISSING) {
+			cluster.DeleteCloudResourceByID(resourceType, resource.Id)
+			slbMissing = slbMissing || resourceType == biz.ResourceType_LOAD_BALANCER
+			a.log.Infof("%s %s is missing, it will be created again", resourceType.String(), resource.RefId)
+		}
+	}
+	err := a.CreateNetwork(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	err = a.ImportKeyPair(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if hasSecurityRuleDrift(drifts) || cluster.GetSingleCloudResource(biz.ResourceType_SECURITY_GROUP) == nil {
+		err = a.ManageSecurityGroup(ctx, cluster)
+		if err != nil {
+			return err
+		}
+	}
+	if slbMissing {
+		return a.ManageSLB(ctx, cluster)
+	}
+	return nil
+}
+
+// ListTaggedCloudResources lists the resources of the region carrying the cloud-copilot managed_by tag
+func (a *AliCloudUsecase) ListTaggedCloudResources(ctx context.Context, region string) ([]*biz.OrphanedCloudResource, error) {
+	managedKey := biz.ResourceTypeKeyValue_MANAGED_BY.String()
+	resources := make([]*biz.OrphanedCloudResource, 0)
+	for resourceType, aliResourceType := range aliVpcTagResourceTypes {
+		resourceTags, err := listAliTaggedResources(func(resourceIds []string, nextToken string) ([]*aliTagResource, string, error) {
+			req := &vpc.ListTagResourcesRequest{
+				RegionId:     tea.String(region),
+				ResourceType: tea.String(aliResourceType),
+				MaxResults:   tea.Int32(50),
+			}
+			if nextToken != "" {
+				req.NextToken = tea.String(nextToken)
+			}
+			if len(resourceIds) == 0 {
+				req.Tag = []*vpc.ListTagResourcesRequestTag{{Key: tea.String(managedKey), Value: tea.String(biz.CloudResourceManagedBy)}}
+			} else {
+				req.ResourceId = tea.StringSlice(resourceIds)
+			}
+			res, err := a.vpcClient.ListTagResources(req)
+			if err != nil {
+				return nil, "", errors.Wrap(err, "failed to list vpc tag resources")
+			}
+			tagResources := make([]*aliTagResource, 0)
+			for _, v := range res.Body.TagResources.TagResource {
+				tagResources = append(tagResources, &aliTagResource{resourceId: tea.StringValue(v.ResourceId), key: tea.StringValue(v.TagKey), value: tea.StringValue(v.TagValue)})
+			}
+			return tagResources, tea.StringValue(res.Body.NextToken), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for resourceId, tags := range resourceTags {
+			resources = append(resources, newTaggedCloudResource(region, resourceType, resourceId, "", tags, aliHourlyCost))
+		}
+	}
+	for resourceType, aliResourceType := range map[biz.ResourceType]string{
+		biz.ResourceType_SECURITY_GROUP: "securitygroup",
+		biz.ResourceType_KEY_PAIR:       "keypair",
+	} {
+		resourceTags, err := listAliTaggedResources(func(resourceIds []string, nextToken string) ([]*aliTagResource, string, error) {
+			req := &ecs.ListTagResourcesRequest{
+				RegionId:     tea.String(region),
+				ResourceType: tea.String(aliResourceType),
+			}
+			if nextToken != "" {
+				req.NextToken = tea.String(nextToken)
+			}
+			if len(resourceIds) == 0 {
+				req.Tag = []*ecs.ListTagResourcesRequestTag{{Key: tea.String(managedKey), Value: tea.String(biz.CloudResourceManagedBy)}}
+			} else {
+				req.ResourceId = tea.StringSlice(resourceIds)
+			}
+			res, err := a.ecsClient.ListTagResources(req)
+			if err != nil {
+				return nil, "", errors.Wrap(err, "failed to list ecs tag resources")
+			}
+			tagResources := make([]*aliTagResource, 0)
+			for _, v := range res.Body.TagResources.TagResource {
+				tagResources = append(tagResources, &aliTagResource{resourceId: tea.StringValue(v.ResourceId), key: tea.StringValue(v.TagKey), value: tea.StringValue(v.TagValue)})
+			}
+			return tagResources, tea.StringValue(res.Body.NextToken), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for resourceId, tags := range resourceTags {
+			resource := newTaggedCloudResource(region, resourceType, resourceId, "", tags, aliHourlyCost)
+			if resourceType == biz.ResourceType_KEY_PAIR {
+				// the key pair id is its name
+				resource.Resource.Name = resourceId
+			}
+			resources = append(resources, resource)
+		}
+	}
+	resourceTags, err := listAliTaggedResources(func(resourceIds []string, nextToken string) ([]*aliTagResource, string, error) {
+		req := &slb.ListTagResourcesRequest{
+			RegionId:     tea.String(region),
+			ResourceType: tea.String("instance"),
+		}
+		if nextToken != "" {
+			req.NextToken = tea.String(nextToken)
+		}
+		if len(resourceIds) == 0 {
+			req.Tag = []*slb.ListTagResourcesRequestTag{{Key: tea.String(managedKey), Value: tea.String(biz.CloudResourceManagedBy)}}
+		} else {
+			req.ResourceId = tea.StringSlice(resourceIds)
+		}
+		res, err := a.slbClient.ListTagResources(req)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to list slb tag resources")
+		}
+		tagResources := make([]*aliTagResource, 0)
+		for _, v := range res.Body.TagResources.TagResource {
+			tagResources = append(tagResources, &aliTagResource{resourceId: tea.StringValue(v.ResourceId), key: tea.StringValue(v.TagKey), value: tea.StringValue(v.TagValue)})
+		}
+		return tagResources, tea.StringValue(res.Body.NextToken), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for resourceId, tags := range resourceTags {
+		resources = append(resources, newTaggedCloudResource(region, biz.ResourceType_LOAD_BALANCER, resourceId, "", tags, aliHourlyCost))
+	}
+	return resources, nil
+}
+
 func (a *AliCloudUsecase) FindImage(regionId string, arch biz.NodeArchType) (*ecs.DescribeImagesResponseBodyImagesImage, error) {
 	archStr := getNodeArchToCloudType(arch)
 	if archStr == "" {
@@ -2033,6 +2433,54 @@ func (a *AliCloudUsecase) createEcsTag(regionID, resourceID, resourceType string
 	return nil
 }
 
+func (a *AliCloudUsecase) createSlbTags(regionID, resourceID string, tags map[biz.ResourceTypeKeyValue]any) error {
+	slbTags := make([]*slb.TagResourcesRequestTag, 0)
+	for key, value := range tags {
+		slbTags = append(slbTags, &slb.TagResourcesRequestTag{
+			Key:   tea.String(key.String()),
+			Value: tea.String(cast.ToString(value)),
+		})
+	}
+	_, err := a.slbClient.TagResources(&slb.TagResourcesRequest{
+		RegionId:     tea.String(regionID),
+		ResourceType: tea.String("instance"),
+		ResourceId:   tea.StringSlice([]string{resourceID}),
+		Tag:          slbTags,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to tag slb")
+	}
+	return nil
+}
+
+// aliTagValues reads the tags of a describe response, the sdk names the tag fields Key or TagKey depending on the api
+func aliTagValues(tags any) map[string]string {
+	tagValues := make(map[string]string)
+	tagsJson, err := json.Marshal(tags)
+	if err != nil {
+		return tagValues
+	}
+	aliTags := struct {
+		Tag []struct {
+			Key      string
+			Value    string
+			TagKey   string
+			TagValue string
+		}
+	}{}
+	if json.Unmarshal(tagsJson, &aliTags) != nil {
+		return tagValues
+	}
+	for _, tag := range aliTags.Tag {
+		if tag.TagKey != "" {
+			tagValues[tag.TagKey] = tag.TagValue
+			continue
+		}
+		tagValues[tag.Key] = tag.Value
+	}
+	return tagValues
+}
+
 func (a *AliCloudUsecase) handlerError(err error) error {
 	if err == nil {
 		return nil
@@ -2042,3 +2490,52 @@ func (a *AliCloudUsecase) handlerError(err error) error {
 	}
 	return err
 }
+
+type aliTagResource struct {
+	resourceId string
+	key        string
+	value      string
+}
+
+// listAliTaggedResources finds the ids carrying the managed_by tag, then reads all their tags,
+// a tag filtered listing only returns the matched tag, list pages one ListTagResources api of the sdk
+func listAliTaggedResources(list func(resourceIds []string, nextToken string) ([]*aliTagResource, string, error)) (map[string]map[string]string, error) {
+	resourceIds := make([]string, 0)
+	nextToken := ""
+	for {
+		tagResources, token, err := list(nil, nextToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, tagResource := range tagResources {
+			if !slices.Contains(resourceIds, tagResource.resourceId) {
+				resourceIds = append(resourceIds, tagResource.resourceId)
+			}
+		}
+		if token == "" {
+			break
+		}
+		nextToken = token
+	}
+	resourceTags := make(map[string]map[string]string)
+	for ids := range slices.Chunk(resourceIds, 20) {
+		nextToken = ""
+		for {
+			tagResources, token, err := list(ids, nextToken)
+			if err != nil {
+				return nil, err
+			}
+			for _, tagResource := range tagResources {
+				if _, ok := resourceTags[tagResource.resourceId]; !ok {
+					resourceTags[tagResource.resourceId] = make(map[string]string)
+				}
+				resourceTags[tagResource.resourceId][tagResource.key] = tagResource.value
+			}
+			if token == "" {
+				break
+			}
+			nextToken = token
+		}
+	}
+	return resourceTags, nil
+}
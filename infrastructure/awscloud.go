@@ -138,7 +138,8 @@ func (a *AwsCloudUsecase) CreateNetwork(ctx context.Context, cluster *biz.Cluste
 
 func (a *AwsCloudUsecase) ImportKeyPair(ctx context.Context, cluster *biz.Cluster) error {
 	keyName := cluster.GetkeyPairName()
-	tags := map[biz.ResourceTypeKeyValue]any{biz.ResourceTypeKeyValue_NAME: keyName}
+	tags := cluster.GetTags()
+	tags[biz.ResourceTypeKeyValue_NAME] = keyName
 	keyPairOutputs, err := a.ec2Client.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{
 		KeyNames: []string{keyName},
 	})
@@ -187,12 +188,9 @@ func (a *AwsCloudUsecase) ImportKeyPair(ctx context.Context, cluster *biz.Cluste
 	return nil
 }
 
-// delete network(vpc, subnet, internet gateway, nat gateway, route table, security group)
+// DeleteNetwork deletes the recorded network resources, the vpc may already be gone when an earlier delete failed partway
 func (a *AwsCloudUsecase) DeleteNetwork(ctx context.Context, cluster *biz.Cluster) error {
 	vpc := cluster.GetSingleCloudResource(biz.ResourceType_VPC)
-	if vpc == nil {
-		return errors.New("vpc not found")
-	}
 	// Delete SLB
 	for _, slb := range cluster.GetCloudResource(biz.ResourceType_LOAD_BALANCER) {
 		_, err := a.elbv2Client.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
@@ -418,15 +416,21 @@ func (a *AwsCloudUsecase) DeleteNetwork(ctx context.Context, cluster *biz.Cluste
 
 	// Delete internatgateway
 	for _, igw := range cluster.GetCloudResource(biz.ResourceType_INTERNET_GATEWAY) {
-		_, err := a.ec2Client.DetachInternetGateway(ctx, &ec2.DetachInternetGatewayInput{
-			InternetGatewayId: aws.String(igw.RefId),
-			VpcId:             aws.String(vpc.RefId),
-		})
-		if err != nil {
-			return errors.Wrap(err, "failed to detach internet gateway")
+		vpcId := igw.AssociatedId
+		if vpc != nil {
+			vpcId = vpc.RefId
 		}
-		time.Sleep(time.Second * TimeOutSecond)
-		_, err = a.ec2Client.DeleteInternetGateway(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: aws.String(igw.RefId)})
+		if vpcId != "" {
+			_, err := a.ec2Client.DetachInternetGateway(ctx, &ec2.DetachInternetGatewayInput{
+				InternetGatewayId: aws.String(igw.RefId),
+				VpcId:             aws.String(vpcId),
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to detach internet gateway")
+			}
+			time.Sleep(time.Second * TimeOutSecond)
+		}
+		_, err := a.ec2Client.DeleteInternetGateway(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: aws.String(igw.RefId)})
 		if err != nil {
 			return errors.Wrap(err, "failed to delete internet gateway")
 		}
@@ -435,6 +439,9 @@ func (a *AwsCloudUsecase) DeleteNetwork(ctx context.Context, cluster *biz.Cluste
 	}
 
 	// Delete VPC
+	if vpc == nil {
+		return nil
+	}
 	vpcRes, err := a.ec2Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 		VpcIds: []string{vpc.RefId},
 	})
@@ -574,15 +581,17 @@ func (a *AwsCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 			}
 			privateSubnetTagsMap := cluster.DecodeTags(privateSubnet.Tags)
 			zoneId := cast.ToString(privateSubnetTagsMap[biz.ResourceTypeKeyValue_ZONE_ID])
+			// a node that can not be created is parked in error and the others go on
 			ok, err := a.checkingInstanceInventory(ctx, node.InstanceType, zoneId)
 			if err != nil {
-				return err
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, err)
+				continue
 			}
 			if !ok {
 				for _, instanceId := range strings.Split(node.BackupInstanceIds, ",") {
 					ok, err = a.checkingInstanceInventory(ctx, instanceId, zoneId)
 					if err != nil {
-						return err
+						break
 					}
 					if ok {
 						node.InstanceId = instanceId
@@ -590,9 +599,12 @@ func (a *AwsCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 					}
 				}
 			}
+			if err != nil {
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, err)
+				continue
+			}
 			if !ok {
-				node.ErrorType = biz.NodeErrorType_INFRASTRUCTURE_ERROR
-				node.ErrorMessage = "INSUFFICIENT INVENTORY"
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, errors.Errorf("insufficient inventory of %s in zone %s", node.InstanceType, zoneId))
 				continue
 			}
 			runInstancesInput := &ec2.RunInstancesInput{
@@ -623,12 +635,15 @@ func (a *AwsCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 			}
 			instancesOutput, err := a.ec2Client.RunInstances(ctx, runInstancesInput)
 			if err != nil {
-				return errors.Wrap(err, "failed to run instances")
+				a.log.Errorf("run instance of node %s failed: %v", node.Name, err)
+				node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, errors.Wrap(err, "failed to run instances"))
+				continue
 			}
 			for _, instance := range instancesOutput.Instances {
 				instanceIds = append(instanceIds, aws.ToString(instance.InstanceId))
 				node.InstanceId = aws.ToString(instance.InstanceId)
 				node.Ip = aws.ToString(instance.PrivateIpAddress)
+				node.HostKey = ""
 			}
 		}
 	}
@@ -640,9 +655,33 @@ func (a *AwsCloudUsecase) ManageInstance(ctx context.Context, cluster *biz.Clust
 			return errors.Wrap(err, "failed to wait for instance running")
 		}
 	}
+	a.seedHostKeys(ctx, cluster)
 	return nil
 }
 
+// seedHostKeys pins the host keys cloud-init printed to the console of the new instances,
+// the console output may not be ready yet and the key is then trusted on the first ssh connection
+func (a *AwsCloudUsecase) seedHostKeys(ctx context.Context, cluster *biz.Cluster) {
+	for _, node := range cluster.Nodes {
+		if node.InstanceId == "" || node.HostKey != "" {
+			continue
+		}
+		output, err := a.ec2Client.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+			InstanceId: aws.String(node.InstanceId),
+			Latest:     aws.Bool(true),
+		})
+		if err != nil {
+			a.log.Warnf("get console output of %s failed: %v", node.InstanceId, err)
+			continue
+		}
+		consoleOutput, err := base64.StdEncoding.DecodeString(aws.ToString(output.Output))
+		if err != nil {
+			continue
+		}
+		node.HostKey = getConsoleHostKey(string(consoleOutput))
+	}
+}
+
 // create vpc
 func (a *AwsCloudUsecase) createVPC(ctx context.Context, cluster *biz.Cluster) error {
 	vpcName := cluster.GetVpcName()
@@ -672,7 +711,8 @@ func (a *AwsCloudUsecase) createVPC(ctx context.Context, cluster *biz.Cluster) e
 	if len(cluster.GetCloudResource(biz.ResourceType_VPC)) > 0 {
 		cluster.DeleteCloudResource(biz.ResourceType_VPC)
 	}
-	vpcTags := map[biz.ResourceTypeKeyValue]any{biz.ResourceTypeKeyValue_NAME: vpcName}
+	vpcTags := cluster.GetTags()
+	vpcTags[biz.ResourceTypeKeyValue_NAME] = vpcName
 	for _, vpc := range vpcs {
 		if len(cluster.GetCloudResource(biz.ResourceType_VPC)) != 0 {
 			return nil
@@ -1492,6 +1532,8 @@ func (a *AwsCloudUsecase) ManageSLB(ctx context.Context, cluster *biz.Cluster) e
 	}
 	if len(cluster.GetCloudResource(biz.ResourceType_LOAD_BALANCER)) == 0 {
 		// Create SLB
+		slbTags := cluster.GetTags()
+		slbTags[biz.ResourceTypeKeyValue_NAME] = slbName
 		slbOutput, CreateLoadBalancerErr := a.elbv2Client.CreateLoadBalancer(ctx, &elasticloadbalancingv2.CreateLoadBalancerInput{
 			Name:           aws.String(slbName),
 			IpAddressType:  elasticloadbalancingv2Types.IpAddressTypeIpv4,
@@ -1499,9 +1541,7 @@ func (a *AwsCloudUsecase) ManageSLB(ctx context.Context, cluster *biz.Cluster) e
 			Type:           elasticloadbalancingv2Types.LoadBalancerTypeEnumNetwork,
 			SecurityGroups: []string{sg.RefId},
 			Subnets:        subnetIds,
-			Tags: a.mapToElbv2Tags(map[biz.ResourceTypeKeyValue]any{
-				biz.ResourceTypeKeyValue_NAME: slbName,
-			}),
+			Tags:           a.mapToElbv2Tags(slbTags),
 		})
 		if CreateLoadBalancerErr != nil {
 			return errors.Wrap(CreateLoadBalancerErr, "failed to create SLB")
@@ -1520,6 +1560,7 @@ func (a *AwsCloudUsecase) ManageSLB(ctx context.Context, cluster *biz.Cluster) e
 		cluster.AddCloudResource(&biz.CloudResource{
 			Name:  slbName,
 			RefId: aws.ToString(slbOutput.LoadBalancers[0].LoadBalancerArn),
+			Tags:  cluster.EncodeTags(slbTags),
 			Type:  biz.ResourceType_LOAD_BALANCER,
 			Value: aws.ToString(slbOutput.LoadBalancers[0].DNSName),
 		})
@@ -1653,6 +1694,337 @@ func (a *AwsCloudUsecase) ManageSLB(ctx context.Context, cluster *biz.Cluster) e
 	return nil
 }
 
+// DetectCloudDrift compares the stored network, security group, key pair and load balancer of the cluster with the live state
+func (a *AwsCloudUsecase) DetectCloudDrift(ctx context.Context, cluster *biz.Cluster) ([]*biz.CloudDrift, error) {
+	vpc := cluster.GetSingleCloudResource(biz.ResourceType_VPC)
+	if vpc == nil {
+		return nil, errors.New("vpc not found")
+	}
+	detector := newCloudDriftDetector(cluster)
+	vpcFilters := []ec2Types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpc.RefId}}}
+
+	vpcRes, err := a.ec2Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{Filters: vpcFilters})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe VPCs")
+	}
+	lives := make([]*liveCloudResource, 0)
+	for _, v := range vpcRes.Vpcs {
+		live := &liveCloudResource{refId: aws.ToString(v.VpcId), tags: awsEc2TagValues(v.Tags)}
+		if aws.ToString(v.CidrBlock) != cluster.VpcCidr {
+			live.details = append(live.details, fmt.Sprintf("cidr is %s, expected %s", aws.ToString(v.CidrBlock), cluster.VpcCidr))
+		}
+		lives = append(lives, live)
+	}
+	detector.compareResources(biz.ResourceType_VPC, lives, false)
+
+	subnetRes, err := a.ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{Filters: vpcFilters, MaxResults: aws.Int32(1000)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe subnets")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, subnet := range subnetRes.Subnets {
+		live := &liveCloudResource{refId: aws.ToString(subnet.SubnetId), tags: awsEc2TagValues(subnet.Tags)}
+		stored := cluster.GetCloudResourceByRefID(biz.ResourceType_SUBNET, live.refId)
+		if stored != nil && stored.Value != "" && stored.Value != aws.ToString(subnet.CidrBlock) {
+			live.details = append(live.details, fmt.Sprintf("cidr is %s, expected %s", aws.ToString(subnet.CidrBlock), stored.Value))
+		}
+		lives = append(lives, live)
+	}
+	detector.compareResources(biz.ResourceType_SUBNET, lives, true)
+
+	internetGatewayRes, err := a.ec2Client.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+		Filters:    []ec2Types.Filter{{Name: aws.String("attachment.vpc-id"), Values: []string{vpc.RefId}}},
+		MaxResults: aws.Int32(100),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe internet gateways")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, internetGateway := range internetGatewayRes.InternetGateways {
+		lives = append(lives, &liveCloudResource{refId: aws.ToString(internetGateway.InternetGatewayId), tags: awsEc2TagValues(internetGateway.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_INTERNET_GATEWAY, lives, false)
+
+	eipRes, err := a.ec2Client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe Elastic IPs")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, eip := range eipRes.Addresses {
+		lives = append(lives, &liveCloudResource{refId: aws.ToString(eip.AllocationId), tags: awsEc2TagValues(eip.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_ELASTIC_IP, lives, false)
+
+	natGatewayRes, err := a.ec2Client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2Types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpc.RefId}},
+			{Name: aws.String("state"), Values: []string{string(ec2Types.NatGatewayStatePending), string(ec2Types.NatGatewayStateAvailable)}},
+		},
+		MaxResults: aws.Int32(500),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nat gateway")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, natGateway := range natGatewayRes.NatGateways {
+		lives = append(lives, &liveCloudResource{refId: aws.ToString(natGateway.NatGatewayId), tags: awsEc2TagValues(natGateway.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_NAT_GATEWAY, lives, true)
+
+	routeTableRes, err := a.ec2Client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{Filters: vpcFilters, MaxResults: aws.Int32(100)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe route tables")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, routeTable := range routeTableRes.RouteTables {
+		// the main route table comes with the vpc
+		if slices.ContainsFunc(routeTable.Associations, func(association ec2Types.RouteTableAssociation) bool {
+			return aws.ToBool(association.Main)
+		}) {
+			continue
+		}
+		lives = append(lives, &liveCloudResource{refId: aws.ToString(routeTable.RouteTableId), tags: awsEc2TagValues(routeTable.Tags)})
+	}
+	detector.compareResources(biz.ResourceType_ROUTE_TABLE, lives, true)
+
+	securityGroupRes, err := a.ec2Client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{Filters: vpcFilters, MaxResults: aws.Int32(100)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe security groups")
+	}
+	lives = make([]*liveCloudResource, 0)
+	for _, securityGroup := range securityGroupRes.SecurityGroups {
+		// the default security group comes with the vpc
+		if aws.ToString(securityGroup.GroupName) == "default" {
+			continue
+		}
+		lives = append(lives, &liveCloudResource{
+			refId: aws.ToString(securityGroup.GroupId),
+			name:  aws.ToString(securityGroup.GroupName),
+			tags:  awsEc2TagValues(securityGroup.Tags),
+		})
+	}
+	detector.compareResources(biz.ResourceType_SECURITY_GROUP, lives, true)
+	for _, securityGroup := range cluster.GetCloudResource(biz.ResourceType_SECURITY_GROUP) {
+		if !slices.ContainsFunc(lives, func(live *liveCloudResource) bool { return live.refId == securityGroup.RefId }) {
+			continue
+		}
+		sgRuleRes, err := a.ec2Client.DescribeSecurityGroupRules(ctx, &ec2.DescribeSecurityGroupRulesInput{
+			Filters:    []ec2Types.Filter{{Name: aws.String("group-id"), Values: []string{securityGroup.RefId}}},
+			MaxResults: aws.Int32(500),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe security group rules")
+		}
+		ruleKeys := make([]string, 0)
+		for _, sgRule := range sgRuleRes.SecurityGroupRules {
+			if aws.ToBool(sgRule.IsEgress) {
+				continue
+			}
+			ruleKeys = append(ruleKeys, biz.SecurityRuleKey(aws.ToString(sgRule.IpProtocol), aws.ToString(sgRule.CidrIpv4), aws.ToInt32(sgRule.FromPort), aws.ToInt32(sgRule.ToPort)))
+		}
+		detector.compareSecurityRules(securityGroup, ruleKeys)
+	}
+
+	lives = make([]*liveCloudResource, 0)
+	keyPairIds := make([]string, 0)
+	for _, keyPair := range cluster.GetCloudResource(biz.ResourceType_KEY_PAIR) {
+		keyPairIds = append(keyPairIds, keyPair.RefId)
+	}
+	if len(keyPairIds) != 0 {
+		keyPairRes, err := a.ec2Client.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{
+			Filters: []ec2Types.Filter{{Name: aws.String("key-pair-id"), Values: keyPairIds}},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe key pair")
+		}
+		for _, keyPair := range keyPairRes.KeyPairs {
+			lives = append(lives, &liveCloudResource{refId: aws.ToString(keyPair.KeyPairId), tags: awsEc2TagValues(keyPair.Tags)})
+		}
+	}
+	detector.compareResources(biz.ResourceType_KEY_PAIR, lives, false)
+
+	lives = make([]*liveCloudResource, 0)
+	for _, slb := range cluster.GetCloudResource(biz.ResourceType_LOAD_BALANCER) {
+		_, err := a.elbv2Client.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: []string{slb.RefId},
+		})
+		if err != nil && strings.Contains(err.Error(), AwsNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe SLB")
+		}
+		tagRes, err := a.elbv2Client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{ResourceArns: []string{slb.RefId}})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe SLB tags")
+		}
+		tagValues := make(map[string]string)
+		for _, tagDescription := range tagRes.TagDescriptions {
+			for _, tag := range tagDescription.Tags {
+				tagValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+		}
+		lives = append(lives, &liveCloudResource{refId: slb.RefId, tags: tagValues})
+	}
+	detector.compareResources(biz.ResourceType_LOAD_BALANCER, lives, false)
+	return detector.drifts, nil
+}
+
+// RepairCloudDrift tags modified resources again, forgets missing ones so the network, key pair,
+// security group and load balancer steps create them again, and sets the security rules back to the cluster rules
+func (a *AwsCloudUsecase) RepairCloudDrift(ctx context.Context, cluster *biz.Cluster, drifts []*biz.CloudDrift) error {
+	slbMissing := false
+	for _, resourceType := range driftResourceTypes {
+		for _, resource := range driftResources(cluster, drifts, resourceType, biz.CloudDriftType_MODIFIED) {
+			var err error
+			if resourceType == biz.ResourceType_LOAD_BALANCER {
+				_, err = a.elbv2Client.AddTags(ctx, &elasticloadbalancingv2.AddTagsInput{
+					ResourceArns: []string{resource.RefId},
+					Tags:         a.mapToElbv2Tags(cluster.CloudTags(resource)),
+				})
+			} else {
+				err = a.createTags(ctx, resource.RefId, resourceType, cluster.CloudTags(resource))
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to tag %s %s", resourceType.String(), resource.RefId)
+			}
+			a.log.Infof("%s %s tagged again", resourceType.String(), resource.RefId)
+		}
+		for _, resource := range driftResources(cluster, drifts, resourceType, biz.CloudDriftType_MISSING) {
+			cluster.DeleteCloudResourceByID(resourceType, resource.Id)
+			slbMissing = slbMissing || resourceType == biz.ResourceType_LOAD_BALANCER
+			a.log.Infof("%s %s is missing, it will be created again", resourceType.String(), resource.RefId)
+		}
+	}
+	err := a.CreateNetwork(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	err = a.ImportKeyPair(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if hasSecurityRuleDrift(drifts) || cluster.GetSingleCloudResource(biz.ResourceType_SECURITY_GROUP) == nil {
+		err = a.ManageSecurityGroup(ctx, cluster)
+		if err != nil {
+			return err
+		}
+	}
+	if slbMissing {
+		return a.ManageSLB(ctx, cluster)
+	}
+	return nil
+}
+
+// ListTaggedCloudResources lists the resources of the region carrying the cloud-copilot managed_by tag
+func (a *AwsCloudUsecase) ListTaggedCloudResources(ctx context.Context, region string) ([]*biz.OrphanedCloudResource, error) {
+	managedFilters := []ec2Types.Filter{{
+		Name:   aws.String("tag:" + biz.ResourceTypeKeyValue_MANAGED_BY.String()),
+		Values: []string{biz.CloudResourceManagedBy},
+	}}
+	resources := make([]*biz.OrphanedCloudResource, 0)
+	add := func(resourceType biz.ResourceType, refId, associatedId string, tags []ec2Types.Tag) {
+		resources = append(resources, newTaggedCloudResource(region, resourceType, refId, associatedId, awsEc2TagValues(tags), awsHourlyCost))
+	}
+
+	vpcRes, err := a.ec2Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{Filters: managedFilters})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe VPCs")
+	}
+	for _, v := range vpcRes.Vpcs {
+		add(biz.ResourceType_VPC, aws.ToString(v.VpcId), "", v.Tags)
+	}
+	subnetRes, err := a.ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{Filters: managedFilters, MaxResults: aws.Int32(1000)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe subnets")
+	}
+	for _, subnet := range subnetRes.Subnets {
+		add(biz.ResourceType_SUBNET, aws.ToString(subnet.SubnetId), aws.ToString(subnet.VpcId), subnet.Tags)
+	}
+	internetGatewayRes, err := a.ec2Client.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{Filters: managedFilters, MaxResults: aws.Int32(100)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe internet gateways")
+	}
+	for _, internetGateway := range internetGatewayRes.InternetGateways {
+		// the attached vpc lets the gateway be detached when the vpc itself is no longer tagged
+		vpcId := ""
+		for _, attachment := range internetGateway.Attachments {
+			vpcId = aws.ToString(attachment.VpcId)
+		}
+		add(biz.ResourceType_INTERNET_GATEWAY, aws.ToString(internetGateway.InternetGatewayId), vpcId, internetGateway.Tags)
+	}
+	natGatewayRes, err := a.ec2Client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+		Filter: append(managedFilters, ec2Types.Filter{
+			Name:   aws.String("state"),
+			Values: []string{string(ec2Types.NatGatewayStatePending), string(ec2Types.NatGatewayStateAvailable)},
+		}),
+		MaxResults: aws.Int32(500),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nat gateway")
+	}
+	for _, natGateway := range natGatewayRes.NatGateways {
+		add(biz.ResourceType_NAT_GATEWAY, aws.ToString(natGateway.NatGatewayId), aws.ToString(natGateway.SubnetId), natGateway.Tags)
+	}
+	routeTableRes, err := a.ec2Client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{Filters: managedFilters, MaxResults: aws.Int32(100)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe route tables")
+	}
+	for _, routeTable := range routeTableRes.RouteTables {
+		add(biz.ResourceType_ROUTE_TABLE, aws.ToString(routeTable.RouteTableId), aws.ToString(routeTable.VpcId), routeTable.Tags)
+	}
+	securityGroupRes, err := a.ec2Client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{Filters: managedFilters, MaxResults: aws.Int32(100)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe security groups")
+	}
+	for _, securityGroup := range securityGroupRes.SecurityGroups {
+		add(biz.ResourceType_SECURITY_GROUP, aws.ToString(securityGroup.GroupId), aws.ToString(securityGroup.VpcId), securityGroup.Tags)
+	}
+	eipRes, err := a.ec2Client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{Filters: managedFilters})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe Elastic IPs")
+	}
+	for _, eip := range eipRes.Addresses {
+		add(biz.ResourceType_ELASTIC_IP, aws.ToString(eip.AllocationId), "", eip.Tags)
+	}
+	keyPairRes, err := a.ec2Client.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{Filters: managedFilters})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe key pair")
+	}
+	for _, keyPair := range keyPairRes.KeyPairs {
+		add(biz.ResourceType_KEY_PAIR, aws.ToString(keyPair.KeyPairId), "", keyPair.Tags)
+		// the key pair is deleted by name
+		resources[len(resources)-1].Resource.Name = aws.ToString(keyPair.KeyName)
+	}
+
+	// load balancers can not be filtered by tag, their tags are read in batches of 20
+	loadBalancerRes, err := a.elbv2Client.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{PageSize: aws.Int32(400)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe SLB")
+	}
+	loadBalancerArns := make([]string, 0)
+	for _, lb := range loadBalancerRes.LoadBalancers {
+		loadBalancerArns = append(loadBalancerArns, aws.ToString(lb.LoadBalancerArn))
+	}
+	for arns := range slices.Chunk(loadBalancerArns, 20) {
+		tagRes, err := a.elbv2Client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{ResourceArns: arns})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe SLB tags")
+		}
+		for _, tagDescription := range tagRes.TagDescriptions {
+			tagValues := make(map[string]string)
+			for _, tag := range tagDescription.Tags {
+				tagValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			if tagValues[biz.ResourceTypeKeyValue_MANAGED_BY.String()] != biz.CloudResourceManagedBy {
+				continue
+			}
+			resources = append(resources, newTaggedCloudResource(region, biz.ResourceType_LOAD_BALANCER, aws.ToString(tagDescription.ResourceArn), "", tagValues, awsHourlyCost))
+		}
+	}
+	return resources, nil
+}
+
 func (a *AwsCloudUsecase) FindImage(ctx context.Context, arch biz.NodeArchType) (ec2Types.Image, error) {
 	image := ec2Types.Image{}
 	images, err := a.ec2Client.DescribeImages(ctx, &ec2.DescribeImagesInput{
@@ -1921,6 +2293,15 @@ func (a *AwsCloudUsecase) mapToEc2Tags(tags map[biz.ResourceTypeKeyValue]any) []
 	return ec2Tags
 }
 
+// ec2 tags by key
+func awsEc2TagValues(tags []ec2Types.Tag) map[string]string {
+	tagValues := make(map[string]string)
+	for _, tag := range tags {
+		tagValues[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tagValues
+}
+
 // map to elbv2 tags
 func (a *AwsCloudUsecase) mapToElbv2Tags(tags map[biz.ResourceTypeKeyValue]any) []elasticloadbalancingv2Types.Tag {
 	elbv2Tags := []elasticloadbalancingv2Types.Tag{}
@@ -4,64 +4,145 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/internal/conf"
 	"github.com/f-rambo/cloud-copilot/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/spf13/cast"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 )
 
 type Baremetal struct {
 	c   *conf.Bootstrap
 	log *log.Helper
+	// guards the host keys pinned on the nodes and the jump host keys pinned on the cluster by concurrent connections
+	hostKeyLock sync.Mutex
+	// one resource upload per cluster node at a time, concurrent steps on the node wait for it
+	resourceUploads singleflight.Group
 }
 
 func NewBaremetal(c *conf.Bootstrap, logger log.Logger) *Baremetal {
 	return &Baremetal{c: c, log: log.NewHelper(logger)}
 }
 
-func (b *Baremetal) getClusterNodeRemoteBash(cluster *biz.Cluster, node *biz.Node) *utils.RemoteBash {
-	return utils.NewRemoteBash(utils.Server{
+// getClusterNodeRemoteBash reaches the node with the port and credential of its inventory host, the cluster private key without one
+func (b *Baremetal) getClusterNodeRemoteBash(cluster *biz.Cluster, node *biz.Node) (*utils.RemoteBash, error) {
+	port, privateKey, password := cluster.GetNodeSshCredential(node)
+	if port == 0 {
+		port = defaultSHHPort
+	}
+	return b.newRemoteBash(cluster, node, utils.Server{
 		Name:       node.Name,
 		Host:       node.Ip,
 		User:       node.Username,
-		Port:       defaultSHHPort,
-		PrivateKey: cluster.PrivateKey,
-	}, b.c.Infrastructure.Shell, b.log)
+		Port:       port,
+		PrivateKey: privateKey,
+		Password:   password,
+	})
 }
 
-func (b *Baremetal) initNode(cluster *biz.Cluster, node *biz.Node) error {
-	remoteBash := b.getClusterNodeRemoteBash(cluster, node)
-	err := remoteBash.ExecShellLogging(NodeInitShell, node.Name)
+// newRemoteBash tunnels the connection to the node through the configured and the cluster jump hosts,
+// the host keys trusted on the first connection are pinned on the node and the cluster
+func (b *Baremetal) newRemoteBash(cluster *biz.Cluster, node *biz.Node, server utils.Server) (*utils.RemoteBash, error) {
+	jumpHosts, err := b.getJumpHosts(cluster)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	userHomePath, err := remoteBash.GetUserHome()
+	b.hostKeyLock.Lock()
+	server.HostKey = node.HostKey
+	b.hostKeyLock.Unlock()
+	server.JumpHosts = jumpHosts
+	return utils.NewRemoteBash(server, b.c.Infrastructure.Shell, b.log).
+		OnHostKeyPinned(func(hostKey string) {
+			b.hostKeyLock.Lock()
+			defer b.hostKeyLock.Unlock()
+			node.HostKey = hostKey
+		}).
+		OnJumpHostKeyPinned(func(addr, hostKey string) {
+			b.hostKeyLock.Lock()
+			defer b.hostKeyLock.Unlock()
+			cluster.SetJumpHostKey(addr, hostKey)
+		}), nil
+}
+
+// getJumpHosts chains the jump hosts of the server config before the ones of the cluster
+func (b *Baremetal) getJumpHosts(cluster *biz.Cluster) ([]utils.JumpHost, error) {
+	b.hostKeyLock.Lock()
+	defer b.hostKeyLock.Unlock()
+	jumpHosts := make([]utils.JumpHost, 0)
+	for _, confJumpHost := range b.c.Infrastructure.GetJumpHosts() {
+		jumpHost := utils.JumpHost{
+			Host:    confJumpHost.Host,
+			User:    confJumpHost.User,
+			Port:    confJumpHost.Port,
+			HostKey: confJumpHost.HostKey,
+		}
+		if confJumpHost.PrivateKeyFile != "" {
+			privateKey, err := os.ReadFile(confJumpHost.PrivateKeyFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "read private key of jump host %s", jumpHost.Addr())
+			}
+			jumpHost.PrivateKey = string(privateKey)
+		}
+		if jumpHost.HostKey == "" {
+			jumpHost.HostKey = cluster.GetJumpHostKey(jumpHost.Addr())
+		}
+		jumpHosts = append(jumpHosts, jumpHost)
+	}
+	clusterJumpHosts, err := utils.ParseProxyJump(cluster.ProxyJump)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cluster %s proxy jump is invalid", cluster.Name)
+	}
+	for _, jumpHost := range clusterJumpHosts {
+		jumpHost.PrivateKey = cluster.JumpPrivateKey
+		if jumpHost.PrivateKey == "" {
+			jumpHost.PrivateKey = cluster.PrivateKey
+		}
+		jumpHost.HostKey = cluster.GetJumpHostKey(jumpHost.Addr())
+		jumpHosts = append(jumpHosts, jumpHost)
+	}
+	return jumpHosts, nil
+}
+
+func (b *Baremetal) initNode(cluster *biz.Cluster, node *biz.Node) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
 	if err != nil {
 		return err
 	}
-	err = remoteBash.ExecShellLogging(
-		KubernetesComponentShell,
-		filepath.Join(userHomePath, b.c.Infrastructure.Resource),
-		cluster.ImageRepository,
-		getKubernetesVersion(b.c.Infrastructure.Resource),
-		getContainerdVersion(b.c.Infrastructure.Resource),
-		getRuncVersion(b.c.Infrastructure.Resource),
-	)
+	err = remoteBash.ExecShellLogging(NodeInitShell, node.Name)
 	if err != nil {
 		return err
 	}
-	return nil
+	return b.getInstaller(cluster).InstallComponent(remoteBash, cluster)
+}
+
+func (b *Baremetal) getInstaller(cluster *biz.Cluster) kubernetesInstaller {
+	return newKubernetesInstaller(b.c, cluster.Distribution)
 }
 
 func (b *Baremetal) migrateResources(cluster *biz.Cluster, node *biz.Node) error {
-	remoteBash := b.getClusterNodeRemoteBash(cluster, node)
+	_, err, _ := b.resourceUploads.Do(fmt.Sprintf("%d/%s", cluster.Id, node.Ip), func() (any, error) {
+		return nil, b.uploadResources(cluster, node)
+	})
+	return err
+}
+
+// uploadResources ships the resource directory once, a node that already has it is skipped
+func (b *Baremetal) uploadResources(cluster *biz.Cluster, node *biz.Node) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
 	userHomePath, err := remoteBash.GetUserHome()
 	if err != nil {
 		return err
@@ -89,6 +170,7 @@ type SystemInfo struct {
 	Cpu                string              `json:"cpu"`
 	Gpu                string              `json:"gpu"`
 	GpuInfo            string              `json:"gpu_info"`
+	Disk               string              `json:"disk"`
 	Ip                 string              `json:"ip"`
 	UnpartitionedDisks []UnpartitionedDisk `json:"unpartitioned_disks"`
 }
@@ -102,7 +184,7 @@ type UnpartitionedDisk struct {
 func (b *Baremetal) GetNodesSystemInfo(ctx context.Context, cluster *biz.Cluster) error {
 	// get all node ip
 	eg := new(errgroup.Group)
-	eg.SetLimit(10)
+	eg.SetLimit(b.getNodeConcurrency())
 	mu := new(sync.Mutex)
 	systemInfos := make([]SystemInfo, 0)
 	for _, node := range cluster.Nodes {
@@ -111,13 +193,11 @@ func (b *Baremetal) GetNodesSystemInfo(ctx context.Context, cluster *biz.Cluster
 		}
 		ip := node.Ip
 		eg.Go(func() error {
-			systemInfoOutput, err := utils.NewRemoteBash(utils.Server{
-				Name:       ip,
-				Host:       ip,
-				User:       cluster.NodeUsername,
-				Port:       defaultSHHPort,
-				PrivateKey: cluster.PrivateKey,
-			}, b.c.Infrastructure.Shell, b.log).ExecShell(SystemInfoShell)
+			remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+			if err != nil {
+				return err
+			}
+			systemInfoOutput, err := remoteBash.ExecShell(SystemInfoShell)
 			if err != nil {
 				b.log.Errorf("node %s connection refused", ip)
 				return nil
@@ -155,6 +235,10 @@ func (b *Baremetal) GetNodesSystemInfo(ctx context.Context, cluster *biz.Cluster
 			nodeGroup.GpuSpec = getGPUSpecByBareMetal(strings.ToLower(info.GpuInfo))
 		}
 		clusterNg := cluster.GetNodeGroupByUniqueKey(nodeGroup.UniqueKey())
+		if host := cluster.GetInventoryHost(info.Ip); host != nil && host.NodeGroup != "" {
+			nodeGroup.Name = host.NodeGroup
+			clusterNg = cluster.GetNodeGroupByName(host.NodeGroup)
+		}
 		if clusterNg == nil {
 			cluster.AddNodeGroup(nodeGroup)
 		} else {
@@ -178,8 +262,169 @@ func (b *Baremetal) GetNodesSystemInfo(ctx context.Context, cluster *biz.Cluster
 	return nil
 }
 
+// GetNodeSystemInfo runs systeminfo.sh on a single node
+func (b *Baremetal) GetNodeSystemInfo(ctx context.Context, cluster *biz.Cluster, node *biz.Node) (*biz.NodeSystemInfo, error) {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return nil, err
+	}
+	systemInfoOutput, err := remoteBash.ExecShell(SystemInfoShell)
+	if err != nil {
+		return nil, err
+	}
+	systemInfo := SystemInfo{}
+	err = json.Unmarshal([]byte(systemInfoOutput), &systemInfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse system info of node %s", node.Name)
+	}
+	nodeSystemInfo := &biz.NodeSystemInfo{
+		Os:      systemInfo.Os,
+		Arch:    getNodeArchByBareMetal(systemInfo.Arch),
+		Cpu:     cast.ToInt32(systemInfo.Cpu),
+		Memory:  cast.ToInt32(systemInfo.Mem),
+		Gpu:     cast.ToInt32(systemInfo.Gpu),
+		GpuInfo: systemInfo.GpuInfo,
+		Disk:    cast.ToInt32(systemInfo.Disk),
+		Disks:   make([]*biz.Disk, 0),
+	}
+	for _, disk := range systemInfo.UnpartitionedDisks {
+		nodeSystemInfo.Disks = append(nodeSystemInfo.Disks, &biz.Disk{
+			Name:   disk.Name,
+			Device: disk.Device,
+			Size:   cast.ToInt32(disk.Size),
+			NodeId: node.Id,
+		})
+	}
+	return nodeSystemInfo, nil
+}
+
+// PreflightNodes runs preflight.sh on the nodes and keeps the checks on each node, a node that can not be reached fails the ssh check
+func (b *Baremetal) PreflightNodes(ctx context.Context, cluster *biz.Cluster, nodes []*biz.Node) error {
+	progress := b.newNodeProgress(ctx, len(nodes))
+	b.runNodes(nodes, func(node *biz.Node) {
+		checks, err := b.preflightNode(cluster, node)
+		if err != nil {
+			checks = []*biz.PreflightCheck{{Name: "ssh", Status: biz.PreflightStatus_FAIL, Message: err.Error()}}
+		}
+		node.SetPreflightChecks(checks)
+		if node.GetPreflightStatus() == biz.PreflightStatus_FAIL {
+			err = errors.New("preflight failed")
+		}
+		progress.finish(node, err)
+	})
+	return nil
+}
+
+// preflightNode checks the ports of the node role and the connection to the bootstrap master,
+// empty arguments are passed as "-" so the shell keeps their positions
+func (b *Baremetal) preflightNode(cluster *biz.Cluster, node *biz.Node) ([]*biz.PreflightCheck, error) {
+	ports := []string{"10250"}
+	if node.Role == biz.NodeRole_MASTER {
+		ports = []string{"6443", "2379", "2380", "10250", "10257", "10259"}
+	}
+	masterAddress := "-"
+	if masterNode := cluster.GetSingleMasterNode(); masterNode != nil && masterNode != node && masterNode.Ip != "" {
+		port, _, _ := cluster.GetNodeSshCredential(masterNode)
+		if port == 0 {
+			port = defaultSHHPort
+		}
+		if cluster.Status == biz.ClusterStatus_RUNNING {
+			port = 6443
+		}
+		masterAddress = net.JoinHostPort(masterNode.Ip, fmt.Sprint(port))
+	}
+	imageRepo := getDefaultKuberentesImageRepo()
+	if cluster.Provider == biz.ClusterProvider_AliCloud || cluster.Provider == biz.ClusterProvider_BareMetal {
+		imageRepo = getAliyunKuberentesImageRepo()
+	}
+	registryHost, _, _ := strings.Cut(imageRepo, "/")
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return nil, err
+	}
+	output, err := remoteBash.ExecShell(PreflightShell,
+		node.Role.String(), strings.Join(ports, ","), masterAddress, registryHost, fmt.Sprint(time.Now().Unix()))
+	if err != nil {
+		return nil, err
+	}
+	checks := make([]*biz.PreflightCheck, 0)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "|", 3)
+		if len(fields) != 3 || biz.PreflightStatusFromString(fields[0]) == biz.PreflightStatus_UNSPECIFIED {
+			continue
+		}
+		checks = append(checks, &biz.PreflightCheck{
+			Name:    fields[1],
+			Status:  biz.PreflightStatusFromString(fields[0]),
+			Message: fields[2],
+		})
+	}
+	if len(checks) == 0 {
+		return nil, errors.Errorf("preflight of node %s returned no checks", node.Name)
+	}
+	return checks, nil
+}
+
+// RebootNode schedules the reboot in the background so the ssh session returns before the connection drops,
+// then waits until the node answers with a new boot id
+func (b *Baremetal) RebootNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	bootId, err := remoteBash.Run(BootIdCommand)
+	if err != nil {
+		return err
+	}
+	err = remoteBash.RunWithLogging(`sudo nohup sh -c "sleep 2 && reboot" > /dev/null 2>&1 &`)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(biz.NodeReadyTimeout)
+	for time.Now().Before(deadline) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		time.Sleep(time.Second * TimeOutSecond)
+		newBootId, err := remoteBash.Run(BootIdCommand)
+		if err == nil && strings.TrimSpace(newBootId) != "" && strings.TrimSpace(newBootId) != strings.TrimSpace(bootId) {
+			return nil
+		}
+	}
+	return errors.Errorf("node %s did not come back after reboot", node.Name)
+}
+
+// ScanNodeHostKey returns the host key the node presents now, it is not trusted until accepted
+func (b *Baremetal) ScanNodeHostKey(ctx context.Context, cluster *biz.Cluster, node *biz.Node) (string, error) {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return "", err
+	}
+	return remoteBash.ScanHostKey()
+}
+
+// ReinitNode ships the resources and runs nodeinit.sh and the component install again
+func (b *Baremetal) ReinitNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	err := b.migrateResources(cluster, node)
+	if err != nil {
+		return err
+	}
+	return b.initNode(cluster, node)
+}
+
 func (b *Baremetal) Install(ctx context.Context, cluster *biz.Cluster) error {
+	err := b.InitControlPlane(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return b.JoinNodes(ctx, cluster)
+}
+
+func (b *Baremetal) InitControlPlane(ctx context.Context, cluster *biz.Cluster) error {
 	masterNode := cluster.GetSingleMasterNode()
+	if masterNode == nil {
+		return errors.New("master node not found")
+	}
 	err := b.migrateResources(cluster, masterNode)
 	if err != nil {
 		return err
@@ -188,23 +433,149 @@ func (b *Baremetal) Install(ctx context.Context, cluster *biz.Cluster) error {
 	if err != nil {
 		return err
 	}
-	err = b.getClusterNodeRemoteBash(cluster, masterNode).ExecShellLogging(
-		KubernetesInitShell,
-		getKubernetesVersion(b.c.Infrastructure.Resource),
-	)
+	err = b.setupVip(cluster, masterNode)
+	if err != nil {
+		return err
+	}
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, masterNode)
 	if err != nil {
 		return err
 	}
+	return b.getInstaller(cluster).InitControlPlane(remoteBash, cluster)
+}
+
+// JoinNodes joins every node but the bootstrap master, a node that fails to join is parked in error
+// and the other nodes carry on
+func (b *Baremetal) JoinNodes(ctx context.Context, cluster *biz.Cluster) error {
+	masterNode := cluster.GetSingleMasterNode()
+	if masterNode == nil {
+		return errors.New("master node not found")
+	}
+	nodes := make([]*biz.Node, 0)
 	for _, node := range cluster.Nodes {
-		if node.Ip == masterNode.Ip {
+		if node.Ip != masterNode.Ip && node.Status != biz.NodeStatus_NODE_ERROR {
+			nodes = append(nodes, node)
+		}
+	}
+	b.joinNodes(cluster, nodes, b.newNodeProgress(ctx, len(nodes)))
+	return nil
+}
+
+func (b *Baremetal) GetKubeConfig(ctx context.Context, cluster *biz.Cluster) (string, error) {
+	masterNode := cluster.GetSingleMasterNode()
+	if masterNode == nil {
+		return "", errors.New("master node not found")
+	}
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, masterNode)
+	if err != nil {
+		return "", err
+	}
+	return b.getInstaller(cluster).KubeConfig(remoteBash, cluster)
+}
+
+// getJoinMasterNode prefers a running control plane node other than the joining one,
+// the bootstrap master may be the node being repaired
+func (b *Baremetal) getJoinMasterNode(cluster *biz.Cluster, node *biz.Node) *biz.Node {
+	for _, masterNode := range cluster.GetMasterNodes() {
+		if masterNode.Ip != node.Ip && masterNode.Status == biz.NodeStatus_NODE_RUNNING {
+			return masterNode
+		}
+	}
+	return cluster.GetSingleMasterNode()
+}
+
+// RemoveEtcdMember drops the node from the stacked etcd cluster through another running control plane node
+func (b *Baremetal) RemoveEtcdMember(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	for _, masterNode := range cluster.GetMasterNodes() {
+		if masterNode.Ip == node.Ip || masterNode.Status != biz.NodeStatus_NODE_RUNNING {
 			continue
 		}
-		err := b.joinCluster(cluster, node)
+		remoteBash, err := b.getClusterNodeRemoteBash(cluster, masterNode)
 		if err != nil {
 			return err
 		}
+		return b.getInstaller(cluster).RemoveEtcdMember(remoteBash, node)
 	}
-	return nil
+	return errors.New("no running control plane node to remove the etcd member from")
+}
+
+// GetNodeCertificates reads the expiry of the control plane certificates on the master
+func (b *Baremetal) GetNodeCertificates(ctx context.Context, cluster *biz.Cluster, node *biz.Node) ([]*biz.Certificate, error) {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := b.getInstaller(cluster).Certificates(remoteBash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read certificates of node %s", node.Name)
+	}
+	for _, certificate := range certificates {
+		certificate.ClusterId = cluster.Id
+		certificate.NodeId = node.Id
+		certificate.NodeName = node.Name
+	}
+	return certificates, nil
+}
+
+func (b *Baremetal) RotateNodeCertificates(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	return b.getInstaller(cluster).RenewCertificates(remoteBash)
+}
+
+// setupVip runs keepalived on a control plane node so the api server virtual ip
+// fails over between masters, the bootstrap master gets the highest priority
+func (b *Baremetal) setupVip(cluster *biz.Cluster, node *biz.Node) error {
+	if cluster.ApiServerVip == "" || node.Role != biz.NodeRole_MASTER {
+		return nil
+	}
+	priority := 100
+	for i, masterNode := range cluster.GetMasterNodes() {
+		if masterNode.Ip == node.Ip {
+			priority -= i
+			break
+		}
+	}
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	return remoteBash.ExecShellLogging(KeepalivedShell, cluster.ApiServerVip, node.Ip, cast.ToString(priority))
+}
+
+// UpgradeNode ships the target kubernetes binaries to the node and runs kubeadm upgrade,
+// the first control plane node applies the new version and every other node follows it
+func (b *Baremetal) UpgradeNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node, version string) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	userHomePath, err := remoteBash.GetUserHome()
+	if err != nil {
+		return err
+	}
+	remoteResroucePath := filepath.Join(userHomePath, b.c.Infrastructure.Resource)
+	archs := []biz.NodeArchType{biz.NodeArchType_AMD64, biz.NodeArchType_ARM64}
+	if nodeGroup := cluster.GetNodeGroup(node.NodeGroupId); nodeGroup != nil && nodeGroup.Arch != biz.NodeArchType_UNSPECIFIED {
+		archs = []biz.NodeArchType{nodeGroup.Arch}
+	}
+	for _, arch := range archs {
+		versionPath := filepath.Join(arch.String(), KubernetesResrouceName, version)
+		if !utils.IsFileExist(filepath.Join(b.c.Infrastructure.Resource, versionPath)) {
+			continue
+		}
+		err = remoteBash.SftpDirectory(filepath.Join(b.c.Infrastructure.Resource, versionPath), filepath.Join(remoteResroucePath, versionPath))
+		if err != nil {
+			return err
+		}
+	}
+	upgradeMode := UpgradeNode
+	if masterNode := cluster.GetSingleMasterNode(); masterNode != nil && masterNode.Id == node.Id {
+		upgradeMode = UpgradeApply
+	}
+	return remoteBash.ExecShellLogging(KubernetesUpgradeShell, remoteResroucePath, version, upgradeMode)
 }
 
 func (b *Baremetal) PreInstall(cluster *biz.Cluster) error {
@@ -217,7 +588,11 @@ func (b *Baremetal) PreInstall(cluster *biz.Cluster) error {
 			if err != nil {
 				return err
 			}
-			err = b.getClusterNodeRemoteBash(cluster, node).ExecShellLogging(CloudCopilotInstallShell,
+			remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+			if err != nil {
+				return err
+			}
+			err = remoteBash.ExecShellLogging(CloudCopilotInstallShell,
 				fmt.Sprintf(`'%s'`, string(clusterJsonByte)))
 			if err != nil {
 				return err
@@ -228,73 +603,178 @@ func (b *Baremetal) PreInstall(cluster *biz.Cluster) error {
 	return nil
 }
 
-func (b *Baremetal) UnInstall(cluster *biz.Cluster) error {
-	for _, node := range cluster.Nodes {
+// UnInstall resets every node, a failing node does not stop the others
+func (b *Baremetal) UnInstall(ctx context.Context, cluster *biz.Cluster) error {
+	progress := b.newNodeProgress(ctx, len(cluster.Nodes))
+	failedNodes := make([]string, 0)
+	mu := new(sync.Mutex)
+	b.runNodes(cluster.Nodes, func(node *biz.Node) {
 		err := b.uninstallNode(cluster, node)
+		progress.finish(node, err)
 		if err != nil {
-			return err
+			b.log.Errorf("reset node %s failed: %v", node.Name, err)
+			mu.Lock()
+			failedNodes = append(failedNodes, node.Name)
+			mu.Unlock()
 		}
+	})
+	if len(failedNodes) != 0 {
+		return errors.Errorf("reset failed on %d of %d nodes: %s", len(failedNodes), len(cluster.Nodes), strings.Join(failedNodes, ","))
 	}
 	return nil
 }
 
-func (b *Baremetal) HandlerNodes(cluster *biz.Cluster) error {
+// HandlerNodes joins the pending nodes and resets the deleting ones, a failing node is parked in error
+// with its status kept for RetryNode and the other nodes carry on
+func (b *Baremetal) HandlerNodes(ctx context.Context, cluster *biz.Cluster) error {
+	pendingNodes := make([]*biz.Node, 0)
+	deletingNodes := make([]*biz.Node, 0)
 	for _, node := range cluster.Nodes {
-		if node.Status == biz.NodeStatus_NODE_PENDING {
-			err := b.joinCluster(cluster, node)
-			if err != nil {
-				return err
-			}
-		}
-		if node.Status == biz.NodeStatus_NODE_DELETING {
-			err := b.uninstallNode(cluster, node)
-			if err != nil {
-				return err
-			}
+		switch node.Status {
+		case biz.NodeStatus_NODE_PENDING:
+			pendingNodes = append(pendingNodes, node)
+		case biz.NodeStatus_NODE_DELETING:
+			deletingNodes = append(deletingNodes, node)
 		}
 	}
+	progress := b.newNodeProgress(ctx, len(pendingNodes)+len(deletingNodes))
+	b.joinNodes(cluster, pendingNodes, progress)
+	b.runNodes(deletingNodes, func(node *biz.Node) {
+		err := b.uninstallNode(cluster, node)
+		progress.finish(node, err)
+		if err != nil {
+			b.log.Errorf("reset node %s failed: %v", node.Name, err)
+			node.SetError(biz.NodeErrorType_CLUSTER_ERROR, err)
+		}
+	})
 	return nil
 }
 
-func (b *Baremetal) joinCluster(cluster *biz.Cluster, node *biz.Node) error {
-	err := b.migrateResources(cluster, node)
+// joinNodes prepares the nodes in parallel and joins them, control plane nodes join one after another before
+// any worker so etcd grows a member at a time, preparation failures are infrastructure errors and join failures
+// cluster errors, a retried join whose preparation already succeeded only runs the join
+func (b *Baremetal) joinNodes(cluster *biz.Cluster, nodes []*biz.Node, progress *nodeProgress) {
+	b.runNodes(nodes, func(node *biz.Node) {
+		if node.ErrorType == biz.NodeErrorType_CLUSTER_ERROR {
+			return
+		}
+		err := b.prepareNode(cluster, node)
+		if err != nil {
+			b.log.Errorf("prepare node %s failed: %v", node.Name, err)
+			node.SetError(biz.NodeErrorType_INFRASTRUCTURE_ERROR, err)
+			progress.finish(node, err)
+		}
+	})
+	preparedNodes := make(map[biz.NodeRole][]*biz.Node)
+	for _, node := range nodes {
+		if node.Status != biz.NodeStatus_NODE_ERROR {
+			preparedNodes[node.Role] = append(preparedNodes[node.Role], node)
+		}
+	}
+	for _, node := range preparedNodes[biz.NodeRole_MASTER] {
+		progress.finish(node, b.joinNode(cluster, node))
+	}
+	b.runNodes(append(preparedNodes[biz.NodeRole_WORKER], preparedNodes[biz.NodeRole_EDGE]...), func(node *biz.Node) {
+		progress.finish(node, b.joinNode(cluster, node))
+	})
+}
+
+func (b *Baremetal) joinNode(cluster *biz.Cluster, node *biz.Node) error {
+	masterNode := b.getJoinMasterNode(cluster, node)
+	if masterNode == nil {
+		err := errors.New("master node not found")
+		node.SetError(biz.NodeErrorType_CLUSTER_ERROR, err)
+		return err
+	}
+	err := b.joinNodeThrough(cluster, masterNode, node)
 	if err != nil {
+		b.log.Errorf("node %s join cluster failed: %v", node.Name, err)
+		node.SetError(biz.NodeErrorType_CLUSTER_ERROR, err)
 		return err
 	}
-	err = b.initNode(cluster, node)
+	node.ClearError()
+	return nil
+}
+
+// joinNodeThrough joins the node with the join command of the master
+func (b *Baremetal) joinNodeThrough(cluster *biz.Cluster, masterNode, node *biz.Node) error {
+	masterRemoteBash, err := b.getClusterNodeRemoteBash(cluster, masterNode)
 	if err != nil {
 		return err
 	}
-	var token, caHash string
-	masterNode := cluster.GetSingleMasterNode()
-	masterNodeRemoteBash := b.getClusterNodeRemoteBash(cluster, masterNode)
-	caHash, err = masterNodeRemoteBash.ExecShell(KubeadmCaTokenShell, GetCaHash)
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
 	if err != nil {
 		return err
 	}
-	token, err = masterNodeRemoteBash.ExecShell(KubeadmCaTokenShell, GetToken)
+	return b.getInstaller(cluster).JoinNode(masterRemoteBash, remoteBash, cluster, node)
+}
+
+func (b *Baremetal) getNodeConcurrency() int {
+	if concurrency := b.c.Infrastructure.GetNodeConcurrency(); concurrency > 0 {
+		return int(concurrency)
+	}
+	return 10
+}
+
+// runNodes runs f on the nodes with at most node_concurrency at a time and returns once every node finished,
+// f keeps the result on the node
+func (b *Baremetal) runNodes(nodes []*biz.Node, f func(node *biz.Node)) {
+	eg := new(errgroup.Group)
+	eg.SetLimit(b.getNodeConcurrency())
+	for _, node := range nodes {
+		eg.Go(func() error {
+			f(node)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+// nodeProgress counts the nodes a step finished and reports the throughput and remaining time on the step
+type nodeProgress struct {
+	ctx       context.Context
+	total     int
+	done      int
+	failed    int
+	startedAt time.Time
+	mu        sync.Mutex
+	log       *log.Helper
+}
+
+func (b *Baremetal) newNodeProgress(ctx context.Context, total int) *nodeProgress {
+	return &nodeProgress{ctx: ctx, total: total, startedAt: time.Now(), log: b.log}
+}
+
+func (p *nodeProgress) finish(node *biz.Node, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		p.failed++
+	} else {
+		p.done++
+	}
+	progress := biz.NewNodeProgress(p.total, p.done, p.failed, p.startedAt)
+	p.log.Infof("node %s finished, %d/%d nodes finished with %d failed, %.1f nodes/min, %ds remaining",
+		node.Name, progress.Done+progress.Failed, progress.Total, progress.Failed, progress.Throughput, progress.Remaining)
+	biz.ReportNodeProgress(p.ctx, progress)
+}
+
+func (b *Baremetal) prepareNode(cluster *biz.Cluster, node *biz.Node) error {
+	err := b.migrateResources(cluster, node)
 	if err != nil {
 		return err
 	}
-	if node.Role == biz.NodeRole_MASTER {
-		return b.getClusterNodeRemoteBash(cluster, node).ExecShellLogging(
-			KubernetesJoinShell,
-			cluster.ApiServerAddress,
-			caHash, token)
+	err = b.initNode(cluster, node)
+	if err != nil {
+		return err
 	}
-	return b.getClusterNodeRemoteBash(cluster, node).ExecShellLogging(
-		KubernetesJoinShell,
-		cluster.ApiServerAddress,
-		caHash, token,
-		ClusterController)
+	return b.setupVip(cluster, node)
 }
 
 func (b *Baremetal) uninstallNode(cluster *biz.Cluster, node *biz.Node) error {
-	return utils.NewRemoteBash(utils.Server{
-		Name:       node.Name,
-		Host:       node.Ip,
-		User:       node.Username,
-		Port:       defaultSHHPort,
-		PrivateKey: cluster.PrivateKey,
-	}, b.c.Infrastructure.Shell, b.log).ExecShellLogging(KubernetesResetShell)
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	return b.getInstaller(cluster).ResetNode(remoteBash)
 }
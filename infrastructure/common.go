@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"path/filepath"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/utils"
+	"golang.org/x/crypto/ssh"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
 )
 
 const (
@@ -25,22 +28,44 @@ var (
 
 	CloudCopilotInstallShell string = "cloud-copilot-install.sh"
 
-	KubeadmCaTokenShell      string = "kubeadm-catoken.sh"
+	KubeadmCaTokenShell      string = "kubernetes-catoken.sh"
 	KubernetesInitShell      string = "kubernetes-init.sh"
 	KubernetesJoinShell      string = "kubernetes-join.sh"
 	KubernetesResetShell     string = "kubernetes-reset.sh"
 	KubernetesComponentShell string = "kubernetes-component.sh"
+	KubernetesUpgradeShell   string = "kubernetes-upgrade.sh"
+	KubernetesEtcdShell      string = "kubernetes-etcd.sh"
+	KubernetesCertsShell     string = "kubernetes-certs.sh"
+	KeepalivedShell          string = "keepalived.sh"
 
 	NodeInitShell   string = "nodeinit.sh"
 	SystemInfoShell string = "systeminfo.sh"
+	PreflightShell  string = "preflight.sh"
 
 	ClusterConfiguration string = "kubernetes-config.yaml"
 
 	ClusterController string = "controller"
 	GetCaHash         string = "get-ca-hash"
 	GetToken          string = "get-token"
+	GetCertificateKey string = "get-certificate-key"
+
+	EtcdMemberRemove      string = "member-remove"
+	EtcdSnapshotSave      string = "snapshot-save"
+	EtcdSnapshotStatus    string = "snapshot-status"
+	EtcdSnapshotRemove    string = "snapshot-remove"
+	EtcdSnapshotRestore   string = "snapshot-restore"
+	EtcdStopControlPlane  string = "stop-control-plane"
+	EtcdStartControlPlane string = "start-control-plane"
+
+	CertsList  string = "list"
+	CertsRenew string = "renew"
+
+	UpgradeApply string = "apply"
+	UpgradeNode  string = "node"
 
 	DefaultRootUser string = "root"
+
+	BootIdCommand string = "cat /proc/sys/kernel/random/boot_id"
 )
 
 func getNodeArchToCloudType(arch biz.NodeArchType) string {
@@ -102,19 +127,49 @@ func getKubernetesVersion(resourcePath string) string {
 		return ""
 	}
 	if len(versionNames) > 0 {
-		slices.Sort(versionNames)
+		sortVersions(versionNames)
 		return versionNames[len(versionNames)-1]
 	}
 	return ""
 }
 
+func getKubernetesVersions(resourcePath string, arch biz.NodeArchType) []string {
+	versionNames, err := utils.ListDirectories(filepath.Join(resourcePath, arch.String(), KubernetesResrouceName))
+	if err != nil {
+		return nil
+	}
+	return versionNames
+}
+
+// sortVersions orders the version directories by semver so v1.9 comes before v1.30,
+// names that are not versions sort first and equal versions fall back to the name, eg: +k3s1 before +k3s2
+func sortVersions(versionNames []string) {
+	slices.SortFunc(versionNames, func(a, b string) int {
+		versionA, errA := utilversion.ParseSemantic(a)
+		versionB, errB := utilversion.ParseSemantic(b)
+		switch {
+		case errA != nil && errB != nil:
+			return strings.Compare(a, b)
+		case errA != nil:
+			return -1
+		case errB != nil:
+			return 1
+		case versionA.LessThan(versionB):
+			return -1
+		case versionB.LessThan(versionA):
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+}
+
 func getContainerdVersion(resourcePath string) string {
 	versionNames, err := utils.ListDirectories(filepath.Join(resourcePath, biz.NodeArchType_ARM64.String(), ContainerdResrouceName))
 	if err != nil {
 		return ""
 	}
 	if len(versionNames) > 0 {
-		slices.Sort(versionNames)
+		sortVersions(versionNames)
 		return versionNames[len(versionNames)-1]
 	}
 	return ""
@@ -126,7 +181,7 @@ func getRuncVersion(resourcePath string) string {
 		return ""
 	}
 	if len(versionNames) > 0 {
-		slices.Sort(versionNames)
+		sortVersions(versionNames)
 		return versionNames[len(versionNames)-1]
 	}
 	return ""
@@ -148,3 +203,26 @@ func getInstallShell(shellDir string, cluster *biz.Cluster) (string, error) {
 	return utils.TransferredMeaningString(map[string]string{"ClusterJsonData": string(clusterJsonByte)},
 		filepath.Join(shellDir, CloudCopilotInstallShell))
 }
+
+// getConsoleHostKey picks the host key cloud-init prints to the instance console, ed25519 is preferred over ecdsa and rsa
+func getConsoleHostKey(consoleOutput string) string {
+	begin := strings.Index(consoleOutput, "-----BEGIN SSH HOST KEY KEYS-----")
+	end := strings.Index(consoleOutput, "-----END SSH HOST KEY KEYS-----")
+	if begin < 0 || end < begin {
+		return ""
+	}
+	hostKeys := make(map[string]string)
+	for _, line := range strings.Split(consoleOutput[begin:end], "\n") {
+		key, err := utils.ParseHostKey(strings.TrimSpace(line))
+		if err != nil {
+			continue
+		}
+		hostKeys[key.Type()] = utils.MarshalHostKey(key)
+	}
+	for _, keyType := range []string{ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521, ssh.KeyAlgoRSA} {
+		if hostKey, ok := hostKeys[keyType]; ok {
+			return hostKey
+		}
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+package infrastructure
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+)
+
+// driftResourceTypes are the stored cloud resources compared with the live state, zones and regions are only lookups
+var driftResourceTypes = []biz.ResourceType{
+	biz.ResourceType_VPC,
+	biz.ResourceType_SUBNET,
+	biz.ResourceType_INTERNET_GATEWAY,
+	biz.ResourceType_ELASTIC_IP,
+	biz.ResourceType_NAT_GATEWAY,
+	biz.ResourceType_ROUTE_TABLE,
+	biz.ResourceType_SECURITY_GROUP,
+	biz.ResourceType_KEY_PAIR,
+	biz.ResourceType_LOAD_BALANCER,
+}
+
+// liveCloudResource is a resource as the provider reports it, details are differences the provider found itself
+type liveCloudResource struct {
+	refId   string
+	name    string
+	tags    map[string]string
+	details []string
+}
+
+// cloudDriftDetector collects the drifts of one cluster while a provider walks its live resources
+type cloudDriftDetector struct {
+	cluster *biz.Cluster
+	drifts  []*biz.CloudDrift
+}
+
+func newCloudDriftDetector(cluster *biz.Cluster) *cloudDriftDetector {
+	return &cloudDriftDetector{cluster: cluster, drifts: make([]*biz.CloudDrift, 0)}
+}
+
+// compareResources flags stored resources of the type that are gone or changed,
+// live resources that are not stored are flagged as unexpected when checkUnexpected is set
+func (d *cloudDriftDetector) compareResources(resourceType biz.ResourceType, lives []*liveCloudResource, checkUnexpected bool) {
+	liveMap := make(map[string]*liveCloudResource)
+	for _, live := range lives {
+		liveMap[live.refId] = live
+	}
+	for _, resource := range d.cluster.GetCloudResource(resourceType) {
+		live, ok := liveMap[resource.RefId]
+		if !ok {
+			d.add(resource.Id, resourceType, resource.RefId, resource.Name, biz.CloudDriftType_MISSING, "not found in the cloud")
+			continue
+		}
+		details := append(compareTagValues(d.cluster.CloudTagValues(resource), live.tags), live.details...)
+		if len(details) != 0 {
+			d.add(resource.Id, resourceType, resource.RefId, resource.Name, biz.CloudDriftType_MODIFIED, strings.Join(details, "; "))
+		}
+	}
+	if !checkUnexpected {
+		return
+	}
+	for _, live := range lives {
+		if d.cluster.GetCloudResourceByRefID(resourceType, live.refId) != nil {
+			continue
+		}
+		d.add("", resourceType, live.refId, live.name, biz.CloudDriftType_UNEXPECTED, "not managed by the cluster")
+	}
+}
+
+// compareSecurityRules compares the cluster rules with the live ingress rule keys of the security group
+func (d *cloudDriftDetector) compareSecurityRules(securityGroup *biz.CloudResource, liveRuleKeys []string) {
+	clusterRuleKeys := make([]string, 0)
+	for _, security := range d.cluster.Securitys {
+		ruleKey := security.RuleKey()
+		clusterRuleKeys = append(clusterRuleKeys, ruleKey)
+		if slices.Contains(liveRuleKeys, ruleKey) {
+			continue
+		}
+		d.add(security.Id, biz.ResourceType_SECURITY_GROUP, securityGroup.RefId, ruleKey, biz.CloudDriftType_MISSING, "security rule not found in the security group")
+	}
+	for _, ruleKey := range liveRuleKeys {
+		if slices.Contains(clusterRuleKeys, ruleKey) {
+			continue
+		}
+		d.add("", biz.ResourceType_SECURITY_GROUP, securityGroup.RefId, ruleKey, biz.CloudDriftType_UNEXPECTED, "security rule not managed by the cluster")
+	}
+}
+
+func (d *cloudDriftDetector) add(resourceId string, resourceType biz.ResourceType, refId, name string, driftType biz.CloudDriftType, detail string) {
+	d.drifts = append(d.drifts, &biz.CloudDrift{
+		ClusterId:    d.cluster.Id,
+		ResourceId:   resourceId,
+		ResourceType: resourceType,
+		RefId:        refId,
+		Name:         name,
+		Type:         driftType,
+		Detail:       detail,
+	})
+}
+
+// compareTagValues reports the stored tags that are missing or changed on the live resource, extra live tags are ignored
+func compareTagValues(stored, live map[string]string) []string {
+	keys := make([]string, 0, len(stored))
+	for key := range stored {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	details := make([]string, 0)
+	for _, key := range keys {
+		liveValue, ok := live[key]
+		if !ok {
+			details = append(details, fmt.Sprintf("tag %s is missing", key))
+			continue
+		}
+		if liveValue != stored[key] {
+			details = append(details, fmt.Sprintf("tag %s is %s, expected %s", key, liveValue, stored[key]))
+		}
+	}
+	return details
+}
+
+// driftResources returns the stored resources of the drifts of the given type
+func driftResources(cluster *biz.Cluster, drifts []*biz.CloudDrift, resourceType biz.ResourceType, driftType biz.CloudDriftType) []*biz.CloudResource {
+	resources := make([]*biz.CloudResource, 0)
+	for _, drift := range drifts {
+		if drift.ResourceType != resourceType || drift.Type != driftType || drift.ResourceId == "" {
+			continue
+		}
+		resource := cluster.GetCloudResourceByID(resourceType, drift.ResourceId)
+		if resource != nil {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// hasSecurityRuleDrift is true when the security group rules differ from the cluster rules
+func hasSecurityRuleDrift(drifts []*biz.CloudDrift) bool {
+	for _, drift := range drifts {
+		if drift.ResourceType == biz.ResourceType_SECURITY_GROUP && drift.Type != biz.CloudDriftType_MODIFIED {
+			return true
+		}
+	}
+	return false
+}
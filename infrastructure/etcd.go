@@ -0,0 +1,326 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	etcdSnapshotDir    = "etcd-snapshot"
+	etcdPeerPort       = 2380
+	s3LocationPrefix   = "s3://"
+	etcdServerStorage  = "etcd"
+	etcdSnapshotTmpDir = "tmp"
+)
+
+type etcdSnapshotStatus struct {
+	Hash      int64 `json:"hash"`
+	Revision  int64 `json:"revision"`
+	TotalKey  int64 `json:"totalKey"`
+	TotalSize int64 `json:"totalSize"`
+}
+
+// SaveEtcdSnapshot takes the snapshot on a running master, copies it to server storage
+// and moves it on to the s3 bucket when the policy asks for it
+func (b *Baremetal) SaveEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	masterNode := b.getEtcdMasterNode(cluster)
+	if masterNode == nil {
+		return errors.New("no running master node")
+	}
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, masterNode)
+	if err != nil {
+		return err
+	}
+	err = remoteBash.ExecShellLogging(KubernetesEtcdShell, EtcdSnapshotSave, snapshot.Name)
+	if err != nil {
+		return err
+	}
+	defer remoteBash.ExecShellLogging(KubernetesEtcdShell, EtcdSnapshotRemove, snapshot.Name)
+	userHome, err := remoteBash.GetUserHome()
+	if err != nil {
+		return err
+	}
+	localFile := utils.GetServerStoragePathByNames(etcdServerStorage, cluster.Name, snapshot.Name)
+	err = remoteBash.SftpDownload(filepath.Join(userHome, etcdSnapshotDir, snapshot.Name), localFile)
+	if err != nil {
+		return err
+	}
+	snapshot.Size, snapshot.Sha256, err = fileSha256(localFile)
+	if err != nil {
+		return err
+	}
+	snapshot.Location = localFile
+	if policy.Storage != biz.EtcdBackupStorage_S3 {
+		return nil
+	}
+	defer os.Remove(localFile)
+	key := path.Join(cluster.Name, snapshot.Name)
+	err = putEtcdSnapshotObject(ctx, policy, key, localFile)
+	if err != nil {
+		return err
+	}
+	snapshot.Location = s3LocationPrefix + path.Join(policy.S3Bucket, key)
+	return nil
+}
+
+// VerifyEtcdSnapshot compares the checksum and reads the snapshot with etcdutl on a running master
+func (b *Baremetal) VerifyEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	localFile, cleanup, err := fetchEtcdSnapshot(ctx, policy, snapshot)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	_, sha, err := fileSha256(localFile)
+	if err != nil {
+		return err
+	}
+	if sha != snapshot.Sha256 {
+		return errors.Errorf("checksum mismatch, expected %s got %s", snapshot.Sha256, sha)
+	}
+	masterNode := b.getEtcdMasterNode(cluster)
+	if masterNode == nil {
+		return errors.New("no running master node")
+	}
+	remoteBash, err := b.uploadEtcdSnapshot(cluster, masterNode, snapshot, localFile)
+	if err != nil {
+		return err
+	}
+	defer remoteBash.ExecShellLogging(KubernetesEtcdShell, EtcdSnapshotRemove, snapshot.Name)
+	output, err := remoteBash.ExecShell(KubernetesEtcdShell, EtcdSnapshotStatus, snapshot.Name)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	status := &etcdSnapshotStatus{}
+	err = json.Unmarshal([]byte(lines[len(lines)-1]), status)
+	if err != nil {
+		return errors.Errorf("etcdutl could not read the snapshot: %s", strings.TrimSpace(output))
+	}
+	snapshot.Revision = status.Revision
+	snapshot.TotalKeys = status.TotalKey
+	return nil
+}
+
+// RestoreEtcdSnapshot replaces the data of every stacked etcd member with the snapshot,
+// all control plane nodes are stopped first so the members start again as one new cluster,
+// a failed restore starts the stopped control plane nodes again and the uploaded snapshots are always removed
+func (b *Baremetal) RestoreEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) (err error) {
+	masterNodes := cluster.GetMasterNodes()
+	if len(masterNodes) == 0 {
+		return errors.New("master node not found")
+	}
+	localFile, cleanup, err := fetchEtcdSnapshot(ctx, policy, snapshot)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	initialCluster := make([]string, 0)
+	for _, node := range masterNodes {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=https://%s:%d", node.Name, node.Ip, etcdPeerPort))
+	}
+	clusterToken := fmt.Sprintf("%s-%d", cluster.Name, time.Now().Unix())
+	uploadedNodes := make([]*biz.Node, 0)
+	defer func() {
+		for _, node := range uploadedNodes {
+			removeErr := b.execEtcdShell(cluster, node, EtcdSnapshotRemove, snapshot.Name)
+			if removeErr != nil {
+				b.log.Errorf("remove etcd snapshot %s from node %s failed: %v", snapshot.Name, node.Name, removeErr)
+			}
+		}
+	}()
+	for _, node := range masterNodes {
+		uploadedNodes = append(uploadedNodes, node)
+		_, err = b.uploadEtcdSnapshot(cluster, node, snapshot, localFile)
+		if err != nil {
+			return err
+		}
+	}
+	stoppedNodes := make([]*biz.Node, 0)
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, node := range stoppedNodes {
+			startErr := b.execEtcdShell(cluster, node, EtcdStartControlPlane)
+			if startErr != nil {
+				b.log.Errorf("start control plane of node %s after the failed restore failed: %v", node.Name, startErr)
+			}
+		}
+	}()
+	for _, node := range masterNodes {
+		// a stop that times out may have moved the manifests already
+		stoppedNodes = append(stoppedNodes, node)
+		err = b.execEtcdShell(cluster, node, EtcdStopControlPlane)
+		if err != nil {
+			return err
+		}
+	}
+	for _, node := range masterNodes {
+		err = b.execEtcdShell(cluster, node, EtcdSnapshotRestore,
+			snapshot.Name, node.Name, strings.Join(initialCluster, ","), fmt.Sprintf("https://%s:%d", node.Ip, etcdPeerPort), clusterToken)
+		if err != nil {
+			return err
+		}
+	}
+	for _, node := range masterNodes {
+		err = b.execEtcdShell(cluster, node, EtcdStartControlPlane)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Baremetal) DeleteEtcdSnapshot(ctx context.Context, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	if bucket, key, ok := parseS3Location(snapshot.Location); ok {
+		_, err := newEtcdSnapshotS3Client(policy).DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		return errors.Wrap(err, "failed to delete snapshot object")
+	}
+	err := os.Remove(snapshot.Location)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (b *Baremetal) getEtcdMasterNode(cluster *biz.Cluster) *biz.Node {
+	for _, node := range cluster.GetMasterNodes() {
+		if node.Status == biz.NodeStatus_NODE_RUNNING {
+			return node
+		}
+	}
+	return nil
+}
+
+func (b *Baremetal) execEtcdShell(cluster *biz.Cluster, node *biz.Node, args ...string) error {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return err
+	}
+	return remoteBash.ExecShellLogging(KubernetesEtcdShell, args...)
+}
+
+func (b *Baremetal) uploadEtcdSnapshot(cluster *biz.Cluster, node *biz.Node, snapshot *biz.EtcdSnapshot, localFile string) (*utils.RemoteBash, error) {
+	remoteBash, err := b.getClusterNodeRemoteBash(cluster, node)
+	if err != nil {
+		return nil, err
+	}
+	userHome, err := remoteBash.GetUserHome()
+	if err != nil {
+		return nil, err
+	}
+	remoteDir := filepath.Join(userHome, etcdSnapshotDir)
+	_, err = remoteBash.Run(fmt.Sprintf("mkdir -p %s", remoteDir))
+	if err != nil {
+		return nil, err
+	}
+	err = remoteBash.SftpFile(localFile, filepath.Join(remoteDir, snapshot.Name))
+	if err != nil {
+		return nil, err
+	}
+	return remoteBash, nil
+}
+
+// fetchEtcdSnapshot returns a local copy of the snapshot, s3 snapshots are downloaded to a temporary file
+func fetchEtcdSnapshot(ctx context.Context, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) (string, func(), error) {
+	bucket, key, ok := parseS3Location(snapshot.Location)
+	if !ok {
+		return snapshot.Location, func() {}, nil
+	}
+	localFile := utils.GetServerStoragePathByNames(etcdServerStorage, etcdSnapshotTmpDir, fmt.Sprintf("%d-%s", time.Now().UnixNano(), snapshot.Name))
+	cleanup := func() { os.Remove(localFile) }
+	output, err := newEtcdSnapshotS3Client(policy).GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", cleanup, errors.Wrap(err, "failed to get snapshot object")
+	}
+	defer output.Body.Close()
+	err = os.MkdirAll(filepath.Dir(localFile), 0755)
+	if err != nil {
+		return "", cleanup, err
+	}
+	file, err := os.Create(localFile)
+	if err != nil {
+		return "", cleanup, err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, output.Body)
+	if err != nil {
+		return "", cleanup, errors.Wrap(err, "failed to download snapshot object")
+	}
+	return localFile, cleanup, nil
+}
+
+func putEtcdSnapshotObject(ctx context.Context, policy *biz.EtcdBackupPolicy, key, localFile string) error {
+	file, err := os.Open(localFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = newEtcdSnapshotS3Client(policy).PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(policy.S3Bucket),
+		Key:    aws.String(key),
+		Body:   file,
+	})
+	return errors.Wrap(err, "failed to put snapshot object")
+}
+
+// newEtcdSnapshotS3Client talks to aws s3 or, with an endpoint set, to any s3 compatible store
+func newEtcdSnapshotS3Client(policy *biz.EtcdBackupPolicy) *s3.Client {
+	region := policy.S3Region
+	if region == "" {
+		region = awsDefaultRegion
+	}
+	return s3.NewFromConfig(aws.Config{
+		Region:      region,
+		Credentials: credentials.NewStaticCredentialsProvider(policy.S3AccessKey, policy.S3SecretKey, ""),
+	}, func(o *s3.Options) {
+		if policy.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(policy.S3Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+}
+
+func parseS3Location(location string) (bucket, key string, ok bool) {
+	if !strings.HasPrefix(location, s3LocationPrefix) {
+		return "", "", false
+	}
+	bucket, key, ok = strings.Cut(strings.TrimPrefix(location, s3LocationPrefix), "/")
+	return bucket, key, ok
+}
+
+func fileSha256(filePath string) (int64, string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+)
+
+const hoursPerMonth = 730
+
+// hourly on-demand list prices in USD, only the resources billed while idle are priced
+var (
+	awsHourlyCost = map[biz.ResourceType]float64{
+		biz.ResourceType_NAT_GATEWAY:   0.045,
+		biz.ResourceType_ELASTIC_IP:    0.005,
+		biz.ResourceType_LOAD_BALANCER: 0.0225,
+	}
+	aliHourlyCost = map[biz.ResourceType]float64{
+		biz.ResourceType_NAT_GATEWAY:   0.03,
+		biz.ResourceType_ELASTIC_IP:    0.003,
+		biz.ResourceType_LOAD_BALANCER: 0.01,
+	}
+)
+
+// newTaggedCloudResource builds a gc candidate from the live tags, the cluster is read from the cluster_id and cluster_name tags
+// and the installation that created it from the copilot_instance_id tag
+func newTaggedCloudResource(region string, resourceType biz.ResourceType, refId, associatedId string, tags map[string]string, hourlyCost map[biz.ResourceType]float64) *biz.OrphanedCloudResource {
+	return &biz.OrphanedCloudResource{
+		Region:      region,
+		ClusterId:   cast.ToInt64(tags[biz.ResourceTypeKeyValue_CLUSTER_ID.String()]),
+		ClusterName: tags[biz.ResourceTypeKeyValue_CLUSTER_NAME.String()],
+		InstanceId:  tags[biz.ResourceTypeKeyValue_INSTANCE_ID.String()],
+		MonthlyCost: hourlyCost[resourceType] * hoursPerMonth,
+		Resource: &biz.CloudResource{
+			Id:           uuid.NewString(),
+			Name:         tags[biz.ResourceTypeKeyValue_NAME.String()],
+			RefId:        refId,
+			AssociatedId: associatedId,
+			Type:         resourceType,
+		},
+	}
+}
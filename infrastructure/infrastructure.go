@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/alibabacloud-go/tea/tea"
@@ -14,24 +15,27 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"github.com/pkg/errors"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
 )
 
-var ProviderSet = wire.NewSet(NewInfrastructure, NewBaremetal, NewAwsCloudUseCase, NewAliCloudUseCase)
+var ProviderSet = wire.NewSet(NewInfrastructure, NewBaremetal, NewAwsCloudUseCase, NewAliCloudUseCase, NewKind)
 
 type Infrastructure struct {
 	c         *conf.Bootstrap
 	baremetal *Baremetal
 	aliCloud  *AliCloudUsecase
 	awsCloud  *AwsCloudUsecase
+	kind      *Kind
 	log       *log.Helper
 }
 
-func NewInfrastructure(c *conf.Bootstrap, baremetal *Baremetal, aliCloud *AliCloudUsecase, awsCloud *AwsCloudUsecase, logger log.Logger) biz.ClusterInfrastructure {
+func NewInfrastructure(c *conf.Bootstrap, baremetal *Baremetal, aliCloud *AliCloudUsecase, awsCloud *AwsCloudUsecase, kind *Kind, logger log.Logger) biz.ClusterInfrastructure {
 	return &Infrastructure{
 		c:         c,
 		baremetal: baremetal,
 		aliCloud:  aliCloud,
 		awsCloud:  awsCloud,
+		kind:      kind,
 		log:       log.NewHelper(logger),
 	}
 }
@@ -83,33 +87,45 @@ func (i *Infrastructure) GetZones(ctx context.Context, cluster *biz.Cluster) (re
 }
 
 func (i *Infrastructure) ManageCloudBasicResource(ctx context.Context, cluster *biz.Cluster) error {
+	err := i.CreateNetwork(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return i.ImportKeyPair(ctx, cluster)
+}
+
+func (i *Infrastructure) CreateNetwork(ctx context.Context, cluster *biz.Cluster) error {
 	if cluster.Provider == biz.ClusterProvider_Aws {
 		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.awsCloud.CreateNetwork(ctx, cluster)
-		if err != nil {
-			return err
-		}
-		err = i.awsCloud.ImportKeyPair(ctx, cluster)
-		if err != nil {
-			return err
-		}
+		return i.awsCloud.CreateNetwork(ctx, cluster)
 	}
 	if cluster.Provider == biz.ClusterProvider_AliCloud {
 		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.aliCloud.CreateNetwork(ctx, cluster)
+		return i.aliCloud.CreateNetwork(ctx, cluster)
+	}
+	return nil
+}
+
+func (i *Infrastructure) ImportKeyPair(ctx context.Context, cluster *biz.Cluster) error {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.aliCloud.ImportKeyPair(ctx, cluster)
+		return i.awsCloud.ImportKeyPair(ctx, cluster)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
+		return i.aliCloud.ImportKeyPair(ctx, cluster)
 	}
 	return nil
 }
@@ -147,49 +163,154 @@ func (i *Infrastructure) DeleteCloudBasicResource(ctx context.Context, cluster *
 }
 
 func (i *Infrastructure) ManageNodeResource(ctx context.Context, cluster *biz.Cluster) error {
+	err := i.ManageSecurityGroup(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	err = i.ManageInstance(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return i.ManageSLB(ctx, cluster)
+}
+
+func (i *Infrastructure) ManageSecurityGroup(ctx context.Context, cluster *biz.Cluster) error {
 	if cluster.Provider == biz.ClusterProvider_Aws {
 		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.awsCloud.ManageSecurityGroup(ctx, cluster)
+		return i.awsCloud.ManageSecurityGroup(ctx, cluster)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.awsCloud.ManageInstance(ctx, cluster)
+		return i.aliCloud.ManageSecurityGroup(ctx, cluster)
+	}
+	return nil
+}
+
+// ManageInstance creates or releases cloud instances, bare metal machines already exist so only the pre-install runs
+func (i *Infrastructure) ManageInstance(ctx context.Context, cluster *biz.Cluster) error {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.awsCloud.ManageSLB(ctx, cluster)
+		return i.awsCloud.ManageInstance(ctx, cluster)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
+		return i.aliCloud.ManageInstance(ctx, cluster)
+	}
+	if cluster.Provider == biz.ClusterProvider_BareMetal {
+		return i.baremetal.PreInstall(cluster)
+	}
+	return nil
+}
+
+func (i *Infrastructure) ManageSLB(ctx context.Context, cluster *biz.Cluster) error {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
+		if err != nil {
+			return err
+		}
+		return i.awsCloud.ManageSLB(ctx, cluster)
 	}
 	if cluster.Provider == biz.ClusterProvider_AliCloud {
 		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey)
 		if err != nil {
 			return err
 		}
-		err = i.aliCloud.ManageSecurityGroup(ctx, cluster)
+		return i.aliCloud.ManageSLB(ctx, cluster)
+	}
+	return nil
+}
+
+func (i *Infrastructure) DetectCloudDrift(ctx context.Context, cluster *biz.Cluster) ([]*biz.CloudDrift, error) {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return nil, err
+		}
+		return i.awsCloud.DetectCloudDrift(ctx, cluster)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return nil, err
+		}
+		return i.aliCloud.DetectCloudDrift(ctx, cluster)
+	}
+	return nil, errors.New("Not support")
+}
+
+func (i *Infrastructure) RepairCloudDrift(ctx context.Context, cluster *biz.Cluster, drifts []*biz.CloudDrift) error {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return err
+		}
+		return i.awsCloud.RepairCloudDrift(ctx, cluster, drifts)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
 		if err != nil {
 			return err
 		}
-		err = i.aliCloud.ManageInstance(ctx, cluster)
+		return i.aliCloud.RepairCloudDrift(ctx, cluster, drifts)
+	}
+	return errors.New("Not support")
+}
+
+func (i *Infrastructure) ListTaggedCloudResources(ctx context.Context, provider biz.ClusterProvider, accessId, accessKey, region string) ([]*biz.OrphanedCloudResource, error) {
+	if provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, accessId, accessKey, region)
+		if err != nil {
+			return nil, err
+		}
+		return i.awsCloud.ListTaggedCloudResources(ctx, region)
+	}
+	if provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, accessId, accessKey, region)
+		if err != nil {
+			return nil, err
+		}
+		return i.aliCloud.ListTaggedCloudResources(ctx, region)
+	}
+	return nil, errors.New("Not support")
+}
+
+// DeleteOrphanedCloudResources deletes the resources of a cluster that is gone, the cluster only carries the resources
+func (i *Infrastructure) DeleteOrphanedCloudResources(ctx context.Context, cluster *biz.Cluster) error {
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
 		if err != nil {
 			return err
 		}
-		err = i.aliCloud.ManageSLB(ctx, cluster)
+		err = i.awsCloud.DeleteNetwork(ctx, cluster)
 		if err != nil {
 			return err
 		}
+		return i.awsCloud.DeleteKeyPair(ctx, cluster)
 	}
-	if cluster.Provider == biz.ClusterProvider_BareMetal {
-		err := i.baremetal.PreInstall(cluster)
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return err
+		}
+		err = i.aliCloud.DeleteNetwork(ctx, cluster)
 		if err != nil {
 			return err
 		}
+		return i.aliCloud.DeleteKeyPair(ctx, cluster)
 	}
-	return nil
+	return errors.New("Not support")
 }
 
 func (i *Infrastructure) GetNodesSystemInfo(ctx context.Context, cluster *biz.Cluster) error {
@@ -299,15 +420,89 @@ func (i *Infrastructure) GetCloudtNodesSystemInfo(ctx context.Context, cluster *
 	return nil
 }
 
-func (i *Infrastructure) Install(ctx context.Context, cluster *biz.Cluster) (err error) {
+// ValidateNodeGroup checks that the region offers an instance type matching the node group spec
+func (i *Infrastructure) ValidateNodeGroup(ctx context.Context, cluster *biz.Cluster, nodeGroup *biz.NodeGroup) error {
+	param := FindInstanceTypeParam{
+		Os:            nodeGroup.Os,
+		CPU:           nodeGroup.Cpu,
+		Memory:        nodeGroup.Memory,
+		Arch:          nodeGroup.Arch,
+		GPU:           nodeGroup.Gpu,
+		GPUSpec:       nodeGroup.GpuSpec,
+		NodeGroupType: nodeGroup.Type,
+	}
+	instanceTypeNumber := 0
+	if cluster.Provider == biz.ClusterProvider_Aws {
+		err := i.awsCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return err
+		}
+		instanceTypes, err := i.awsCloud.FindInstanceType(ctx, param)
+		if err != nil {
+			return err
+		}
+		instanceTypeNumber = len(instanceTypes)
+	}
+	if cluster.Provider == biz.ClusterProvider_AliCloud {
+		err := i.aliCloud.Connections(ctx, cluster.AccessId, cluster.AccessKey, cluster.Region)
+		if err != nil {
+			return err
+		}
+		instanceTypes, err := i.aliCloud.FindInstanceType(param)
+		if err != nil {
+			return err
+		}
+		instanceTypeNumber = len(instanceTypes)
+	}
+	if !cluster.Provider.IsCloud() {
+		return nil
+	}
+	if instanceTypeNumber == 0 {
+		return errors.Errorf("no instance type in region %s matches node group %s", cluster.Region, nodeGroup.Name)
+	}
+	return nil
+}
+
+func (i *Infrastructure) GetNodeSystemInfo(ctx context.Context, cluster *biz.Cluster, node *biz.Node) (*biz.NodeSystemInfo, error) {
+	return i.baremetal.GetNodeSystemInfo(ctx, cluster, node)
+}
+
+func (i *Infrastructure) RebootNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	return i.baremetal.RebootNode(ctx, cluster, node)
+}
+
+func (i *Infrastructure) ReinitNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	return i.baremetal.ReinitNode(ctx, cluster, node)
+}
+
+func (i *Infrastructure) ScanNodeHostKey(ctx context.Context, cluster *biz.Cluster, node *biz.Node) (string, error) {
+	return i.baremetal.ScanNodeHostKey(ctx, cluster, node)
+}
+
+func (i *Infrastructure) PreflightNodes(ctx context.Context, cluster *biz.Cluster, nodes []*biz.Node) error {
+	return i.baremetal.PreflightNodes(ctx, cluster, nodes)
+}
+
+func (i *Infrastructure) Install(ctx context.Context, cluster *biz.Cluster) error {
+	err := i.InitControlPlane(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return i.JoinNodes(ctx, cluster)
+}
+
+func (i *Infrastructure) InitControlPlane(ctx context.Context, cluster *biz.Cluster) (err error) {
 	cluster.SetApiServerAddress()
 	if cluster.ApiServerAddress == "" {
 		return errors.New("api server address is empty")
 	}
-	cluster.SetKubernetesVersion(getKubernetesVersion(i.c.Infrastructure.Resource))
+	cluster.SetKubernetesVersion(newKubernetesInstaller(i.c, cluster.Distribution).Version(cluster))
 	if cluster.KubernetesVersion == "" {
 		return errors.New("kubernetes version is empty")
 	}
+	if cluster.Distribution == biz.ClusterDistribution_K3S {
+		return i.baremetal.InitControlPlane(ctx, cluster)
+	}
 	k8sImageRepo := getDefaultKuberentesImageRepo()
 	if cluster.Provider == biz.ClusterProvider_AliCloud || cluster.Provider == biz.ClusterProvider_BareMetal {
 		k8sImageRepo = getAliyunKuberentesImageRepo()
@@ -326,21 +521,103 @@ func (i *Infrastructure) Install(ctx context.Context, cluster *biz.Cluster) (err
 	if err != nil {
 		return err
 	}
-	err = i.baremetal.Install(ctx, cluster)
+	return i.baremetal.InitControlPlane(ctx, cluster)
+}
+
+func (i *Infrastructure) JoinNodes(ctx context.Context, cluster *biz.Cluster) error {
+	return i.baremetal.JoinNodes(ctx, cluster)
+}
+
+func (i *Infrastructure) ValidateKubernetesUpgrade(_ context.Context, cluster *biz.Cluster, version string) error {
+	target, err := utilversion.ParseSemantic(version)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "invalid kubernetes version")
 	}
-	return nil
+	if cluster.KubernetesVersion != "" {
+		current, err := utilversion.ParseSemantic(cluster.KubernetesVersion)
+		if err != nil {
+			return errors.Wrap(err, "invalid cluster kubernetes version")
+		}
+		if !current.LessThan(target) {
+			return errors.Errorf("kubernetes version %s is not newer than %s", version, cluster.KubernetesVersion)
+		}
+		// kubeadm only supports upgrading one minor version at a time
+		if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+			return errors.Errorf("cannot upgrade from %s to %s, skipping minor versions is not supported", cluster.KubernetesVersion, version)
+		}
+	}
+	for _, arch := range []biz.NodeArchType{biz.NodeArchType_AMD64, biz.NodeArchType_ARM64} {
+		if slices.Contains(getKubernetesVersions(i.c.Infrastructure.Resource, arch), version) {
+			return nil
+		}
+	}
+	return errors.Errorf("kubernetes version %s not found in resource", version)
+}
+
+func (i *Infrastructure) UpgradeNode(ctx context.Context, cluster *biz.Cluster, node *biz.Node, version string) error {
+	return i.baremetal.UpgradeNode(ctx, cluster, node, version)
+}
+
+func (i *Infrastructure) RemoveEtcdMember(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	return i.baremetal.RemoveEtcdMember(ctx, cluster, node)
+}
+
+func (i *Infrastructure) GetNodeCertificates(ctx context.Context, cluster *biz.Cluster, node *biz.Node) ([]*biz.Certificate, error) {
+	return i.baremetal.GetNodeCertificates(ctx, cluster, node)
+}
+
+func (i *Infrastructure) RotateNodeCertificates(ctx context.Context, cluster *biz.Cluster, node *biz.Node) error {
+	return i.baremetal.RotateNodeCertificates(ctx, cluster, node)
 }
 
-func (i *Infrastructure) UnInstall(_ context.Context, cluster *biz.Cluster) error {
-	return i.baremetal.UnInstall(cluster)
+func (i *Infrastructure) UnInstall(ctx context.Context, cluster *biz.Cluster) error {
+	return i.baremetal.UnInstall(ctx, cluster)
 }
 
-func (i *Infrastructure) HandlerNodes(_ context.Context, cluster *biz.Cluster) error {
-	return i.baremetal.HandlerNodes(cluster)
+func (i *Infrastructure) HandlerNodes(ctx context.Context, cluster *biz.Cluster) error {
+	return i.baremetal.HandlerNodes(ctx, cluster)
 }
 
 func (i *Infrastructure) WaitClusterSlbReady(_ context.Context, cluster *biz.Cluster) error {
 	return nil
 }
+
+func (i *Infrastructure) SaveEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	return i.baremetal.SaveEtcdSnapshot(ctx, cluster, policy, snapshot)
+}
+
+func (i *Infrastructure) VerifyEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	return i.baremetal.VerifyEtcdSnapshot(ctx, cluster, policy, snapshot)
+}
+
+func (i *Infrastructure) RestoreEtcdSnapshot(ctx context.Context, cluster *biz.Cluster, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	return i.baremetal.RestoreEtcdSnapshot(ctx, cluster, policy, snapshot)
+}
+
+func (i *Infrastructure) DeleteEtcdSnapshot(ctx context.Context, policy *biz.EtcdBackupPolicy, snapshot *biz.EtcdSnapshot) error {
+	return i.baremetal.DeleteEtcdSnapshot(ctx, policy, snapshot)
+}
+
+func (i *Infrastructure) CreateKindCluster(ctx context.Context, cluster *biz.Cluster) error {
+	return i.kind.CreateCluster(ctx, cluster)
+}
+
+func (i *Infrastructure) StopKindCluster(ctx context.Context, cluster *biz.Cluster) error {
+	return i.kind.StopCluster(ctx, cluster)
+}
+
+func (i *Infrastructure) DeleteKindCluster(ctx context.Context, cluster *biz.Cluster) error {
+	return i.kind.DeleteCluster(ctx, cluster)
+}
+
+func (i *Infrastructure) LoadKindImage(ctx context.Context, cluster *biz.Cluster, image string) error {
+	return i.kind.LoadImage(ctx, cluster, image)
+}
+
+// GetKubeConfig reads the admin kubeconfig of the cluster, the runtime talks to the cluster through it
+func (i *Infrastructure) GetKubeConfig(ctx context.Context, cluster *biz.Cluster) (string, error) {
+	if cluster.Provider == biz.ClusterProvider_Kind {
+		return i.kind.GetKubeConfig(ctx, cluster)
+	}
+	return i.baremetal.GetKubeConfig(ctx, cluster)
+}
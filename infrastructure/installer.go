@@ -0,0 +1,285 @@
+package infrastructure
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/f-rambo/cloud-copilot/internal/conf"
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	K3sResrouceName   string = "k3s"
+	defaultK3sVersion string = "v1.33.1+k3s1"
+
+	kubeadmAdminKubeConfig = "/etc/kubernetes/admin.conf"
+	k3sAdminKubeConfig     = "/etc/rancher/k3s/k3s.yaml"
+)
+
+// certificateKeyPattern matches the 32 byte hex key kubeadm encrypts the uploaded control plane certificates with
+var certificateKeyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+var (
+	K3sInstallShell   string = "k3s.sh"
+	K3sCaTokenShell   string = "k3s-catoken.sh"
+	K3sInitShell      string = "k3s-init.sh"
+	K3sJoinShell      string = "k3s-join.sh"
+	K3sResetShell     string = "k3s-reset.sh"
+	K3sComponentShell string = "k3s-component.sh"
+	K3sCertsShell     string = "k3s-certs.sh"
+)
+
+// kubernetesInstaller runs the distribution specific scripts that install, join and reset cluster nodes
+type kubernetesInstaller interface {
+	Version(cluster *biz.Cluster) string
+	InstallComponent(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error
+	InitControlPlane(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error
+	JoinNode(masterRemoteBash, remoteBash *utils.RemoteBash, cluster *biz.Cluster, node *biz.Node) error
+	ResetNode(remoteBash *utils.RemoteBash) error
+	RemoveEtcdMember(masterRemoteBash *utils.RemoteBash, node *biz.Node) error
+	KubeConfig(masterRemoteBash *utils.RemoteBash, cluster *biz.Cluster) (string, error)
+	Certificates(remoteBash *utils.RemoteBash) ([]*biz.Certificate, error)
+	RenewCertificates(remoteBash *utils.RemoteBash) error
+}
+
+func newKubernetesInstaller(c *conf.Bootstrap, distribution biz.ClusterDistribution) kubernetesInstaller {
+	if distribution == biz.ClusterDistribution_K3S {
+		return &k3sInstaller{c: c}
+	}
+	return &kubeadmInstaller{c: c}
+}
+
+type kubeadmInstaller struct {
+	c *conf.Bootstrap
+}
+
+// Version keeps the nodes of an installed cluster on its version, a staged upgrade only applies through UpgradeCluster,
+// a cluster without a version takes the newest staged one
+func (k *kubeadmInstaller) Version(cluster *biz.Cluster) string {
+	if cluster.KubernetesVersion != "" {
+		return cluster.KubernetesVersion
+	}
+	return getKubernetesVersion(k.c.Infrastructure.Resource)
+}
+
+func (k *kubeadmInstaller) InstallComponent(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error {
+	userHomePath, err := remoteBash.GetUserHome()
+	if err != nil {
+		return err
+	}
+	return remoteBash.ExecShellLogging(
+		KubernetesComponentShell,
+		filepath.Join(userHomePath, k.c.Infrastructure.Resource),
+		cluster.ImageRepository,
+		k.Version(cluster),
+		getContainerdVersion(k.c.Infrastructure.Resource),
+		getRuncVersion(k.c.Infrastructure.Resource),
+	)
+}
+
+func (k *kubeadmInstaller) InitControlPlane(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error {
+	return remoteBash.ExecShellLogging(KubernetesInitShell, k.Version(cluster))
+}
+
+func (k *kubeadmInstaller) JoinNode(masterRemoteBash, remoteBash *utils.RemoteBash, cluster *biz.Cluster, node *biz.Node) error {
+	caHash, err := masterRemoteBash.ExecShell(KubeadmCaTokenShell, GetCaHash)
+	if err != nil {
+		return err
+	}
+	token, err := masterRemoteBash.ExecShell(KubeadmCaTokenShell, GetToken)
+	if err != nil {
+		return err
+	}
+	if node.Role == biz.NodeRole_MASTER {
+		certificateKey, err := masterRemoteBash.ExecShell(KubeadmCaTokenShell, GetCertificateKey)
+		if err != nil {
+			return err
+		}
+		certificateKey = strings.TrimSpace(certificateKey)
+		if !certificateKeyPattern.MatchString(certificateKey) {
+			return errors.Errorf("master returned an invalid certificate key %q", certificateKey)
+		}
+		return remoteBash.ExecShellLogging(
+			KubernetesJoinShell,
+			cluster.ApiServerAddress,
+			strings.TrimSpace(caHash), strings.TrimSpace(token),
+			ClusterController, certificateKey)
+	}
+	return remoteBash.ExecShellLogging(
+		KubernetesJoinShell,
+		cluster.ApiServerAddress,
+		strings.TrimSpace(caHash), strings.TrimSpace(token))
+}
+
+func (k *kubeadmInstaller) ResetNode(remoteBash *utils.RemoteBash) error {
+	return remoteBash.ExecShellLogging(KubernetesResetShell)
+}
+
+func (k *kubeadmInstaller) RemoveEtcdMember(masterRemoteBash *utils.RemoteBash, node *biz.Node) error {
+	return masterRemoteBash.ExecShellLogging(KubernetesEtcdShell, EtcdMemberRemove, node.Name)
+}
+
+func (k *kubeadmInstaller) KubeConfig(masterRemoteBash *utils.RemoteBash, cluster *biz.Cluster) (string, error) {
+	return readKubeConfig(masterRemoteBash, kubeadmAdminKubeConfig)
+}
+
+// Certificates reads the expiry of the kubeadm managed certificates and the client certificates of the kubeconfigs
+func (k *kubeadmInstaller) Certificates(remoteBash *utils.RemoteBash) ([]*biz.Certificate, error) {
+	output, err := remoteBash.ExecShell(KubernetesCertsShell, CertsList)
+	if err != nil {
+		return nil, err
+	}
+	return parseCertificates(output)
+}
+
+// RenewCertificates runs kubeadm certs renew and restarts the static pods so they load the new certificates
+func (k *kubeadmInstaller) RenewCertificates(remoteBash *utils.RemoteBash) error {
+	return remoteBash.ExecShellLogging(KubernetesCertsShell, CertsRenew)
+}
+
+// k3sInstaller bundles containerd and the control plane in the k3s binary,
+// servers share the embedded etcd and agents join with the server node token
+type k3sInstaller struct {
+	c *conf.Bootstrap
+}
+
+// Version keeps the nodes of an installed cluster on its version, a cluster without one takes the newest staged k3s
+func (k *k3sInstaller) Version(cluster *biz.Cluster) string {
+	if cluster.KubernetesVersion != "" {
+		return cluster.KubernetesVersion
+	}
+	versionNames, err := utils.ListDirectories(filepath.Join(k.c.Infrastructure.Resource, biz.NodeArchType_ARM64.String(), K3sResrouceName))
+	if err != nil || len(versionNames) == 0 {
+		return defaultK3sVersion
+	}
+	sortVersions(versionNames)
+	return versionNames[len(versionNames)-1]
+}
+
+func (k *k3sInstaller) InstallComponent(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error {
+	userHomePath, err := remoteBash.GetUserHome()
+	if err != nil {
+		return err
+	}
+	return remoteBash.ExecShellLogging(K3sComponentShell, filepath.Join(userHomePath, k.c.Infrastructure.Resource), k.Version(cluster))
+}
+
+func (k *k3sInstaller) InitControlPlane(remoteBash *utils.RemoteBash, cluster *biz.Cluster) error {
+	installShellPath, err := k.uploadInstallShell(remoteBash)
+	if err != nil {
+		return err
+	}
+	return remoteBash.ExecShellLogging(K3sInitShell, cluster.ApiServerAddress, installShellPath, cluster.PodCidr, cluster.ServiceCidr, k.Version(cluster))
+}
+
+func (k *k3sInstaller) JoinNode(masterRemoteBash, remoteBash *utils.RemoteBash, cluster *biz.Cluster, node *biz.Node) error {
+	token, err := masterRemoteBash.ExecShell(K3sCaTokenShell, GetToken)
+	if err != nil {
+		return err
+	}
+	installShellPath, err := k.uploadInstallShell(remoteBash)
+	if err != nil {
+		return err
+	}
+	if node.Role == biz.NodeRole_MASTER {
+		return remoteBash.ExecShellLogging(K3sJoinShell, cluster.ApiServerAddress, strings.TrimSpace(token), installShellPath, k.Version(cluster),
+			ClusterController, cluster.PodCidr, cluster.ServiceCidr)
+	}
+	return remoteBash.ExecShellLogging(K3sJoinShell, cluster.ApiServerAddress, strings.TrimSpace(token), installShellPath, k.Version(cluster))
+}
+
+func (k *k3sInstaller) ResetNode(remoteBash *utils.RemoteBash) error {
+	return remoteBash.ExecShellLogging(K3sResetShell)
+}
+
+// RemoveEtcdMember is left to k3s, its etcd controller removes the member once the node object is deleted
+func (k *k3sInstaller) RemoveEtcdMember(masterRemoteBash *utils.RemoteBash, node *biz.Node) error {
+	return nil
+}
+
+// KubeConfig points the k3s admin kubeconfig, written for the local server, at the api server address
+func (k *k3sInstaller) KubeConfig(masterRemoteBash *utils.RemoteBash, cluster *biz.Cluster) (string, error) {
+	kubeConfig, err := readKubeConfig(masterRemoteBash, k3sAdminKubeConfig)
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(kubeConfig, "https://127.0.0.1:", fmt.Sprintf("https://%s:", cluster.ApiServerAddress)), nil
+}
+
+func (k *k3sInstaller) Certificates(remoteBash *utils.RemoteBash) ([]*biz.Certificate, error) {
+	output, err := remoteBash.ExecShell(K3sCertsShell, CertsList)
+	if err != nil {
+		return nil, err
+	}
+	return parseCertificates(output)
+}
+
+// RenewCertificates stops k3s while it rotates the certificates, the server regenerates them on start
+func (k *k3sInstaller) RenewCertificates(remoteBash *utils.RemoteBash) error {
+	return remoteBash.ExecShellLogging(K3sCertsShell, CertsRenew)
+}
+
+// uploadInstallShell copies the k3s install script next to the other shells, the init and join shells run it
+func (k *k3sInstaller) uploadInstallShell(remoteBash *utils.RemoteBash) (string, error) {
+	userHomePath, err := remoteBash.GetUserHome()
+	if err != nil {
+		return "", err
+	}
+	installShellPath := filepath.Join(userHomePath, k.c.Infrastructure.Shell, K3sInstallShell)
+	err = remoteBash.SftpFile(filepath.Join(k.c.Infrastructure.Shell, K3sInstallShell), installShellPath)
+	if err != nil {
+		return "", err
+	}
+	return installShellPath, nil
+}
+
+func readKubeConfig(remoteBash *utils.RemoteBash, kubeConfigPath string) (string, error) {
+	kubeConfig, err := remoteBash.Run("sudo", "cat", kubeConfigPath)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(kubeConfig) == "" {
+		return "", errors.Errorf("kubeconfig %s is empty", kubeConfigPath)
+	}
+	return kubeConfig, nil
+}
+
+// parseCertificates reads the "name|base64 pem" lines the certs shells print
+func parseCertificates(output string) ([]*biz.Certificate, error) {
+	certificates := make([]*biz.Certificate, 0)
+	for _, line := range strings.Split(output, "\n") {
+		name, data, ok := strings.Cut(strings.TrimSpace(line), "|")
+		if !ok || name == "" || data == "" {
+			continue
+		}
+		pemData, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode certificate %s", name)
+		}
+		block, _ := pem.Decode(pemData)
+		if block == nil {
+			return nil, errors.Errorf("certificate %s is not pem encoded", name)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse certificate %s", name)
+		}
+		certificates = append(certificates, &biz.Certificate{
+			Name:      name,
+			Issuer:    cert.Issuer.CommonName,
+			ExpiresAt: cert.NotAfter.Local().Format(time.DateTime),
+		})
+	}
+	if len(certificates) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return certificates, nil
+}
@@ -0,0 +1,209 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/f-rambo/cloud-copilot/internal/conf"
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KindLocalImageShell string = "kind-local-image.sh"
+
+	kindConfigApiVersion = "kind.x-k8s.io/v1alpha4"
+	kindNodeImage        = "kindest/node"
+	kindWaitTimeout      = "5m"
+	kindServerStorage    = "kind"
+)
+
+type kindConfig struct {
+	Kind       string            `yaml:"kind"`
+	ApiVersion string            `yaml:"apiVersion"`
+	Networking kindNetworking    `yaml:"networking,omitempty"`
+	Nodes      []*kindNodeConfig `yaml:"nodes"`
+}
+
+type kindNetworking struct {
+	PodSubnet     string `yaml:"podSubnet,omitempty"`
+	ServiceSubnet string `yaml:"serviceSubnet,omitempty"`
+}
+
+type kindNodeConfig struct {
+	Role   string            `yaml:"role"`
+	Image  string            `yaml:"image,omitempty"`
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+// Kind runs local clusters as docker containers on the cloud-copilot host with the kind and docker cli
+type Kind struct {
+	c    *conf.Bootstrap
+	bash *utils.Bash
+	log  *log.Helper
+}
+
+func NewKind(c *conf.Bootstrap, logger log.Logger) *Kind {
+	logHelper := log.NewHelper(logger)
+	return &Kind{c: c, bash: utils.NewBash(logHelper), log: logHelper}
+}
+
+// CreateCluster creates the kind cluster from the cluster nodes, a stopped kind cluster is started again
+func (k *Kind) CreateCluster(ctx context.Context, cluster *biz.Cluster) error {
+	exists, err := k.clusterExists(cluster)
+	if err != nil {
+		return err
+	}
+	if exists {
+		err = k.startCluster(cluster)
+	} else {
+		err = k.createCluster(cluster)
+	}
+	if err != nil {
+		return err
+	}
+	return k.setNodeInfo(cluster)
+}
+
+func (k *Kind) StopCluster(ctx context.Context, cluster *biz.Cluster) error {
+	nodeNames, err := k.getNodeNames(cluster)
+	if err != nil {
+		return err
+	}
+	if len(nodeNames) == 0 {
+		return nil
+	}
+	return k.bash.RunCommandWithLogging("docker", append([]string{"stop"}, nodeNames...)...)
+}
+
+func (k *Kind) DeleteCluster(ctx context.Context, cluster *biz.Cluster) error {
+	err := k.bash.RunCommandWithLogging("kind", "delete", "cluster", "--name", cluster.Name)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(k.configPath(cluster))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (k *Kind) LoadImage(ctx context.Context, cluster *biz.Cluster, image string) error {
+	return k.bash.RunCommandWithLogging("bash", filepath.Join(k.c.Infrastructure.Shell, KindLocalImageShell), image, cluster.Name)
+}
+
+func (k *Kind) GetKubeConfig(ctx context.Context, cluster *biz.Cluster) (string, error) {
+	return k.bash.RunCommand("kind", "get", "kubeconfig", "--name", cluster.Name)
+}
+
+func (k *Kind) createCluster(cluster *biz.Cluster) error {
+	config := &kindConfig{
+		Kind:       "Cluster",
+		ApiVersion: kindConfigApiVersion,
+		Networking: kindNetworking{PodSubnet: cluster.PodCidr, ServiceSubnet: cluster.ServiceCidr},
+		Nodes:      make([]*kindNodeConfig, 0),
+	}
+	image := ""
+	if cluster.KubernetesVersion != "" {
+		image = fmt.Sprintf("%s:%s", kindNodeImage, cluster.KubernetesVersion)
+	}
+	// kind names the nodes by the order of the config, control plane first
+	for _, role := range []biz.NodeRole{biz.NodeRole_MASTER, biz.NodeRole_WORKER} {
+		for _, node := range cluster.Nodes {
+			if node.Role != role || node.DeleteNode() {
+				continue
+			}
+			nodeConfig := &kindNodeConfig{Role: "worker", Image: image}
+			if role == biz.NodeRole_MASTER {
+				nodeConfig.Role = "control-plane"
+			}
+			if node.Labels != "" {
+				err := json.Unmarshal([]byte(node.Labels), &nodeConfig.Labels)
+				if err != nil {
+					return errors.Wrapf(err, "invalid labels of node %s", node.Name)
+				}
+			}
+			config.Nodes = append(config.Nodes, nodeConfig)
+		}
+	}
+	configByte, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	configPath := k.configPath(cluster)
+	err = os.MkdirAll(filepath.Dir(configPath), 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(configPath, configByte, 0644)
+	if err != nil {
+		return err
+	}
+	return k.bash.RunCommandWithLogging("kind", "create", "cluster", "--name", cluster.Name, "--config", configPath, "--wait", kindWaitTimeout)
+}
+
+func (k *Kind) startCluster(cluster *biz.Cluster) error {
+	nodeNames, err := k.getNodeNames(cluster)
+	if err != nil {
+		return err
+	}
+	err = k.bash.RunCommandWithLogging("docker", append([]string{"start"}, nodeNames...)...)
+	if err != nil {
+		return err
+	}
+	// points the current kubeconfig context back at this cluster
+	return k.bash.RunCommandWithLogging("kind", "export", "kubeconfig", "--name", cluster.Name)
+}
+
+// setNodeInfo fills in the container ips, the api server address and the kubernetes version of the node image
+func (k *Kind) setNodeInfo(cluster *biz.Cluster) error {
+	for _, node := range cluster.Nodes {
+		if node.DeleteNode() {
+			continue
+		}
+		ip, err := k.bash.RunCommand("docker", "inspect", "-f", "{{range .NetworkSettings.Networks}}{{.IPAddress}}{{end}}", node.Name)
+		if err != nil {
+			return err
+		}
+		node.Ip = strings.TrimSpace(ip)
+	}
+	masterNode := cluster.GetSingleMasterNode()
+	if masterNode == nil {
+		return errors.New("master node not found")
+	}
+	cluster.ApiServerAddress = masterNode.Ip
+	kubeletVersion, err := k.bash.RunCommand("docker", "exec", masterNode.Name, "kubelet", "--version")
+	if err != nil {
+		return err
+	}
+	cluster.SetKubernetesVersion(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(kubeletVersion), "Kubernetes")))
+	return nil
+}
+
+func (k *Kind) clusterExists(cluster *biz.Cluster) (bool, error) {
+	output, err := k.bash.RunCommand("kind", "get", "clusters")
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(strings.Fields(output), cluster.Name), nil
+}
+
+func (k *Kind) getNodeNames(cluster *biz.Cluster) ([]string, error) {
+	output, err := k.bash.RunCommand("kind", "get", "nodes", "--name", cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(output), nil
+}
+
+func (k *Kind) configPath(cluster *biz.Cluster) string {
+	return utils.GetServerStoragePathByNames(kindServerStorage, fmt.Sprintf("%s.yaml", cluster.Name))
+}
@@ -14,7 +14,7 @@ func (c ContextKey) String() string {
 }
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewClusterUseCase, NewAppUsecase, NewServicesUseCase, NewUseUser, NewProjectUseCase, NewWorkspaceUsecase)
+var ProviderSet = wire.NewSet(NewClusterUseCase, NewAppUsecase, NewServicesUseCase, NewUseUser, NewProjectUseCase, NewWorkspaceUsecase, NewCloudResourceGCUsecase)
 
 const (
 	WorkspaceName string = "workspace"
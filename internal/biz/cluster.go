@@ -8,6 +8,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	confPkg "github.com/f-rambo/cloud-copilot/internal/conf"
 	"github.com/f-rambo/cloud-copilot/utils"
@@ -20,7 +21,14 @@ import (
 const (
 	ClusterPoolNumber = 10
 
+	NodeDrainTimeout = 10 * time.Minute
+	NodeReadyTimeout = 10 * time.Minute
+
+	// consecutive not ready probes of the cluster check, one a minute, before a master is declared lost
+	ControlPlaneLostProbes = 5
+
 	ClusterKey ContextKey = "cluster"
+	EventKey   ContextKey = "event"
 
 	// local, jump, prod
 	Env_local = "local"
@@ -57,6 +65,23 @@ const (
 	EventSource_USER        EventSource = 5
 )
 
+func (es EventSource) String() string {
+	switch es {
+	case EventSource_CLUSTER:
+		return "cluster"
+	case EventSource_APP:
+		return "app"
+	case EventSource_PROJECT:
+		return "project"
+	case EventSource_SERVICE:
+		return "service"
+	case EventSource_USER:
+		return "user"
+	default:
+		return "unspecified"
+	}
+}
+
 type EventAction int32
 
 const (
@@ -66,6 +91,19 @@ const (
 	EventAction_DELETE      EventAction = 3
 )
 
+func (ea EventAction) String() string {
+	switch ea {
+	case EventAction_CREATE:
+		return "create"
+	case EventAction_UPDATE:
+		return "update"
+	case EventAction_DELETE:
+		return "delete"
+	default:
+		return "unspecified"
+	}
+}
+
 type EventStatus int32
 
 const (
@@ -74,20 +112,236 @@ const (
 	EventStatus_PROCESSING  EventStatus = 2
 	EventStatus_SUCCESS     EventStatus = 3
 	EventStatus_FAILED      EventStatus = 4
+	EventStatus_DEAD_LETTER EventStatus = 5
+)
+
+func (es EventStatus) String() string {
+	switch es {
+	case EventStatus_PENDING:
+		return "pending"
+	case EventStatus_PROCESSING:
+		return "processing"
+	case EventStatus_SUCCESS:
+		return "success"
+	case EventStatus_FAILED:
+		return "failed"
+	case EventStatus_DEAD_LETTER:
+		return "dead_letter"
+	default:
+		return "unspecified"
+	}
+}
+
+func EventStatusFromString(s string) EventStatus {
+	switch s {
+	case "pending":
+		return EventStatus_PENDING
+	case "processing":
+		return EventStatus_PROCESSING
+	case "success":
+		return EventStatus_SUCCESS
+	case "failed":
+		return EventStatus_FAILED
+	case "dead_letter":
+		return EventStatus_DEAD_LETTER
+	default:
+		return EventStatus_UNSPECIFIED
+	}
+}
+
+// EventType separates queued operations from the steps recorded while handling them
+type EventType int32
+
+const (
+	EventType_UNSPECIFIED EventType = 0
+	EventType_OPERATION   EventType = 1
+	EventType_STEP        EventType = 2
+	EventType_WARNING     EventType = 3
+)
+
+func (et EventType) String() string {
+	switch et {
+	case EventType_OPERATION:
+		return "operation"
+	case EventType_STEP:
+		return "step"
+	case EventType_WARNING:
+		return "warning"
+	default:
+		return "unspecified"
+	}
+}
+
+func EventTypeFromString(s string) EventType {
+	switch s {
+	case "operation":
+		return EventType_OPERATION
+	case "step":
+		return EventType_STEP
+	case "warning":
+		return EventType_WARNING
+	default:
+		return EventType_UNSPECIFIED
+	}
+}
+
+// cluster operation steps recorded on the timeline
+const (
+	ClusterStepGetZones                 = "get_zones"
+	ClusterStepManageCloudBasicResource = "manage_cloud_basic_resource"
+	ClusterStepDeleteCloudBasicResource = "delete_cloud_basic_resource"
+	ClusterStepGetNodesSystemInfo       = "get_nodes_system_info"
+	ClusterStepManageNodeResource       = "manage_node_resource"
+	ClusterStepHandlerNodes             = "handler_nodes"
+	ClusterStepInstall                  = "install"
+	ClusterStepUnInstall                = "uninstall"
+	ClusterStepRuntimeInstall           = "runtime_install"
+	ClusterStepRepairCloudDrift         = "repair_cloud_drift"
+)
+
+// ClusterStep is a checkpointed step of the cluster provisioning graph, in execution order,
+// steps added later take the next value to keep the stored checkpoints and run where provisionSteps places them
+type ClusterStep int32
+
+const (
+	ClusterStep_UNSPECIFIED     ClusterStep = 0
+	ClusterStep_NETWORK         ClusterStep = 1
+	ClusterStep_KEY_PAIR        ClusterStep = 2
+	ClusterStep_SYSTEM_INFO     ClusterStep = 3
+	ClusterStep_SECURITY_GROUP  ClusterStep = 4
+	ClusterStep_INSTANCES       ClusterStep = 5
+	ClusterStep_SLB             ClusterStep = 6
+	ClusterStep_KUBEADM_INIT    ClusterStep = 7
+	ClusterStep_JOINS           ClusterStep = 8
+	ClusterStep_RUNTIME_INSTALL ClusterStep = 9
+	ClusterStep_PREFLIGHT       ClusterStep = 10
+)
+
+func (cs ClusterStep) String() string {
+	switch cs {
+	case ClusterStep_NETWORK:
+		return "network"
+	case ClusterStep_KEY_PAIR:
+		return "key_pair"
+	case ClusterStep_SYSTEM_INFO:
+		return "system_info"
+	case ClusterStep_SECURITY_GROUP:
+		return "security_group"
+	case ClusterStep_INSTANCES:
+		return "instances"
+	case ClusterStep_SLB:
+		return "slb"
+	case ClusterStep_KUBEADM_INIT:
+		return "kubeadm_init"
+	case ClusterStep_JOINS:
+		return "joins"
+	case ClusterStep_RUNTIME_INSTALL:
+		return "runtime_install"
+	case ClusterStep_PREFLIGHT:
+		return "preflight"
+	default:
+		return "unspecified"
+	}
+}
+
+func ClusterStepFromString(s string) ClusterStep {
+	for step := ClusterStep_NETWORK; step <= ClusterStep_PREFLIGHT; step++ {
+		if step.String() == s {
+			return step
+		}
+	}
+	return ClusterStep_UNSPECIFIED
+}
+
+type ClusterStepStatus int32
+
+const (
+	ClusterStepStatus_UNSPECIFIED ClusterStepStatus = 0
+	ClusterStepStatus_PENDING     ClusterStepStatus = 1
+	ClusterStepStatus_RUNNING     ClusterStepStatus = 2
+	ClusterStepStatus_SUCCESS     ClusterStepStatus = 3
+	ClusterStepStatus_FAILED      ClusterStepStatus = 4
+	ClusterStepStatus_SKIPPED     ClusterStepStatus = 5
 )
 
+func (css ClusterStepStatus) String() string {
+	switch css {
+	case ClusterStepStatus_PENDING:
+		return "pending"
+	case ClusterStepStatus_RUNNING:
+		return "running"
+	case ClusterStepStatus_SUCCESS:
+		return "success"
+	case ClusterStepStatus_FAILED:
+		return "failed"
+	case ClusterStepStatus_SKIPPED:
+		return "skipped"
+	default:
+		return "unspecified"
+	}
+}
+
+// ClusterCheckpoint persists the progress of one provisioning step so a retry resumes where it failed
+type ClusterCheckpoint struct {
+	Id        int64             `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId int64             `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;index"`
+	Step      ClusterStep       `json:"step,omitempty" gorm:"column:step;default:0;NOT NULL"`
+	Status    ClusterStepStatus `json:"status,omitempty" gorm:"column:status;default:0;NOT NULL"`
+	Error     string            `json:"error,omitempty" gorm:"column:error;default:'';NOT NULL"`
+	Attempts  int32             `json:"attempts,omitempty" gorm:"column:attempts;default:0;NOT NULL"`
+	UpdatedAt string            `json:"updated_at,omitempty" gorm:"column:updated_at;default:'';NOT NULL"`
+}
+
+func (cc *ClusterCheckpoint) IsDone() bool {
+	return cc.Status == ClusterStepStatus_SUCCESS || cc.Status == ClusterStepStatus_SKIPPED
+}
+
 type Event struct {
-	Id        string      `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
-	Name      string      `json:"name,omitempty" gorm:"column:name;default:'';NOT NULL"`
-	Source    EventSource `json:"source,omitempty" gorm:"column:source;default:0;NOT NULL"`
-	Action    EventAction `json:"action,omitempty" gorm:"column:action;default:0;NOT NULL"`
-	Status    EventStatus `json:"status,omitempty" gorm:"column:status;default:0;NOT NULL"`
-	SourceId  int64       `json:"source_id,omitempty" gorm:"column:source_id;default:0;NOT NULL"`
-	Data      string      `json:"data,omitempty" gorm:"column:data;default:'';NOT NULL"`
-	Error     string      `json:"error,omitempty" gorm:"column:error;default:'';NOT NULL"`
-	CreatedAt string      `json:"created_at,omitempty" gorm:"column:created_at;default:'';NOT NULL"`
+	Id         int64       `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	Name       string      `json:"name,omitempty" gorm:"column:name;default:'';NOT NULL"`
+	Type       EventType   `json:"type,omitempty" gorm:"column:type;default:0;NOT NULL;index"`
+	ParentId   int64       `json:"parent_id,omitempty" gorm:"column:parent_id;default:0;NOT NULL;index"`
+	Source     EventSource `json:"source,omitempty" gorm:"column:source;default:0;NOT NULL;index"`
+	Action     EventAction `json:"action,omitempty" gorm:"column:action;default:0;NOT NULL"`
+	Status     EventStatus `json:"status,omitempty" gorm:"column:status;default:0;NOT NULL;index"`
+	SourceId   int64       `json:"source_id,omitempty" gorm:"column:source_id;default:0;NOT NULL;index"`
+	Data       string      `json:"data,omitempty" gorm:"column:data;default:'';NOT NULL"`
+	Error      string      `json:"error,omitempty" gorm:"column:error;default:'';NOT NULL"`
+	Attempts   int32       `json:"attempts,omitempty" gorm:"column:attempts;default:0;NOT NULL"`
+	NextRunAt  int64       `json:"next_run_at,omitempty" gorm:"column:next_run_at;default:0;NOT NULL"` // unix seconds
+	StartedAt  string      `json:"started_at,omitempty" gorm:"column:started_at;default:'';NOT NULL"`
+	FinishedAt string      `json:"finished_at,omitempty" gorm:"column:finished_at;default:'';NOT NULL"`
+	Duration   int64       `json:"duration,omitempty" gorm:"column:duration;default:0;NOT NULL"` // milliseconds
+	CreatedAt  string      `json:"created_at,omitempty" gorm:"column:created_at;default:'';NOT NULL"`
+	UpdatedAt  string      `json:"updated_at,omitempty" gorm:"column:updated_at;default:'';NOT NULL"`
+}
+
+type EventFilter struct {
+	SourceId int64
+	ParentId int64
+	Type     EventType
+	Status   EventStatus
+	Name     string
+	Page     int32
+	PageSize int32
+}
+
+// WithEvent carries the operation event, the steps of the operation report node progress through the context too
+func WithEvent(ctx context.Context, event *Event) context.Context {
+	return context.WithValue(withStepProgress(ctx), EventKey, event)
+}
+
+func GetEvent(ctx context.Context) *Event {
+	event, ok := ctx.Value(EventKey).(*Event)
+	if !ok {
+		return nil
+	}
+	return event
 }
 
+// ErrClusterEventAborted marks a cluster event failure that must not be retried
+var ErrClusterEventAborted = errors.New("cluster event aborted")
+
 type ClusterNamespace int32
 
 const (
@@ -123,6 +377,7 @@ const (
 	ClusterProvider_BareMetal   ClusterProvider = 1
 	ClusterProvider_Aws         ClusterProvider = 2
 	ClusterProvider_AliCloud    ClusterProvider = 3
+	ClusterProvider_Kind        ClusterProvider = 4
 )
 
 // ClusterProvider to string
@@ -134,6 +389,8 @@ func (cp ClusterProvider) String() string {
 		return "aws"
 	case ClusterProvider_AliCloud:
 		return "ali_cloud"
+	case ClusterProvider_Kind:
+		return "kind"
 	default:
 		return ""
 	}
@@ -147,6 +404,8 @@ func ClusterProviderFromString(s string) ClusterProvider {
 		return ClusterProvider_Aws
 	case "ali_cloud":
 		return ClusterProvider_AliCloud
+	case "kind":
+		return ClusterProvider_Kind
 	default:
 		return 0
 	}
@@ -163,6 +422,8 @@ const (
 	ClusterStatus_STOPPED     ClusterStatus = 5
 	ClusterStatus_DELETED     ClusterStatus = 6
 	ClusterStatus_ERROR       ClusterStatus = 7
+	ClusterStatus_UPGRADING   ClusterStatus = 8
+	ClusterStatus_RESTORING   ClusterStatus = 9
 )
 
 // ClusterStatus to string
@@ -182,6 +443,10 @@ func (cs ClusterStatus) String() string {
 		return "deleted"
 	case ClusterStatus_ERROR:
 		return "error"
+	case ClusterStatus_UPGRADING:
+		return "upgrading"
+	case ClusterStatus_RESTORING:
+		return "restoring"
 	default:
 		return "unspecified"
 	}
@@ -210,10 +475,58 @@ func (cl ClusterLevel) String() string {
 	}
 }
 
+func ClusterLevelFromString(s string) ClusterLevel {
+	switch s {
+	case "basic":
+		return ClusterLevel_BASIC
+	case "standard":
+		return ClusterLevel_STANDARD
+	case "advanced":
+		return ClusterLevel_ADVANCED
+	default:
+		return ClusterLevel_UNSPECIFIED
+	}
+}
+
 func (c *Cluster) SetLevel(level ClusterLevel) {
 	c.Level = level
 }
 
+type ClusterDistribution int32
+
+const (
+	ClusterDistribution_UNSPECIFIED ClusterDistribution = 0
+	ClusterDistribution_KUBEADM     ClusterDistribution = 1
+	ClusterDistribution_K3S         ClusterDistribution = 2
+)
+
+// ClusterDistribution to string
+func (cd ClusterDistribution) String() string {
+	switch cd {
+	case ClusterDistribution_KUBEADM:
+		return "kubeadm"
+	case ClusterDistribution_K3S:
+		return "k3s"
+	default:
+		return "unspecified"
+	}
+}
+
+func ClusterDistributionFromString(s string) ClusterDistribution {
+	switch s {
+	case "kubeadm":
+		return ClusterDistribution_KUBEADM
+	case "k3s":
+		return ClusterDistribution_K3S
+	default:
+		return ClusterDistribution_UNSPECIFIED
+	}
+}
+
+func (c *Cluster) SetDistribution(distribution ClusterDistribution) {
+	c.Distribution = distribution
+}
+
 type NodeRole int32
 
 const (
@@ -237,6 +550,19 @@ func (nr NodeRole) String() string {
 	}
 }
 
+func NodeRoleFromString(s string) NodeRole {
+	switch s {
+	case "master":
+		return NodeRole_MASTER
+	case "worker":
+		return NodeRole_WORKER
+	case "edge":
+		return NodeRole_EDGE
+	default:
+		return NodeRole_UNSPECIFIED
+	}
+}
+
 type NodeStatus int32
 
 const (
@@ -249,6 +575,11 @@ const (
 	NodeStatus_NODE_DELETING NodeStatus = 6
 	NodeStatus_NODE_DELETED  NodeStatus = 7
 	NodeStatus_NODE_ERROR    NodeStatus = 8
+	// node operations queued through the cluster event
+	NodeStatus_NODE_REBOOTING      NodeStatus = 9
+	NodeStatus_NODE_REINITIALIZING NodeStatus = 10
+	NodeStatus_NODE_REPLACING      NodeStatus = 11
+	NodeStatus_NODE_ROTATING_CERTS NodeStatus = 12
 )
 
 // NodeStatus to string
@@ -270,11 +601,50 @@ func (ns NodeStatus) String() string {
 		return "node_deleted"
 	case NodeStatus_NODE_ERROR:
 		return "node_error"
+	case NodeStatus_NODE_REBOOTING:
+		return "node_rebooting"
+	case NodeStatus_NODE_REINITIALIZING:
+		return "node_reinitializing"
+	case NodeStatus_NODE_REPLACING:
+		return "node_replacing"
+	case NodeStatus_NODE_ROTATING_CERTS:
+		return "node_rotating_certs"
 	default:
 		return "unspecified"
 	}
 }
 
+func NodeStatusFromString(s string) NodeStatus {
+	switch s {
+	case "node_ready":
+		return NodeStatus_NODE_READY
+	case "node_finding":
+		return NodeStatus_NODE_FINDING
+	case "node_creating":
+		return NodeStatus_NODE_CREATING
+	case "node_pending":
+		return NodeStatus_NODE_PENDING
+	case "node_running":
+		return NodeStatus_NODE_RUNNING
+	case "node_deleting":
+		return NodeStatus_NODE_DELETING
+	case "node_deleted":
+		return NodeStatus_NODE_DELETED
+	case "node_error":
+		return NodeStatus_NODE_ERROR
+	case "node_rebooting":
+		return NodeStatus_NODE_REBOOTING
+	case "node_reinitializing":
+		return NodeStatus_NODE_REINITIALIZING
+	case "node_replacing":
+		return NodeStatus_NODE_REPLACING
+	case "node_rotating_certs":
+		return NodeStatus_NODE_ROTATING_CERTS
+	default:
+		return NodeStatus_UNSPECIFIED
+	}
+}
+
 type NodeGroupType int32
 
 const (
@@ -307,6 +677,25 @@ func (ngt NodeGroupType) String() string {
 	}
 }
 
+func NodeGroupTypeFromString(s string) NodeGroupType {
+	switch s {
+	case "normal":
+		return NodeGroupType_NORMAL
+	case "high_computation":
+		return NodeGroupType_HIGH_COMPUTATION
+	case "gpu_accelerated":
+		return NodeGroupType_GPU_ACCELERATERD
+	case "high_memory":
+		return NodeGroupType_HIGH_MEMORY
+	case "large_hard_disk":
+		return NodeGroupType_LARGE_HARD_DISK
+	case "load_disk":
+		return NodeGroupType_LOAD_DISK
+	default:
+		return 0
+	}
+}
+
 type NodeArchType int32
 
 const (
@@ -443,6 +832,10 @@ const (
 	ResourceTypeKeyValue_REGION_ID      ResourceTypeKeyValue = 4
 	ResourceTypeKeyValue_ACCESS_PRIVATE ResourceTypeKeyValue = 5
 	ResourceTypeKeyValue_ACCESS_PUBLIC  ResourceTypeKeyValue = 6
+	ResourceTypeKeyValue_MANAGED_BY     ResourceTypeKeyValue = 7
+	ResourceTypeKeyValue_CLUSTER_ID     ResourceTypeKeyValue = 8
+	ResourceTypeKeyValue_CLUSTER_NAME   ResourceTypeKeyValue = 9
+	ResourceTypeKeyValue_INSTANCE_ID    ResourceTypeKeyValue = 10
 )
 
 // ResourceTypeKeyValue to string
@@ -460,6 +853,14 @@ func (kv ResourceTypeKeyValue) String() string {
 		return "access_private"
 	case ResourceTypeKeyValue_ACCESS_PUBLIC:
 		return "access_public"
+	case ResourceTypeKeyValue_MANAGED_BY:
+		return "managed_by"
+	case ResourceTypeKeyValue_CLUSTER_ID:
+		return "cluster_id"
+	case ResourceTypeKeyValue_CLUSTER_NAME:
+		return "cluster_name"
+	case ResourceTypeKeyValue_INSTANCE_ID:
+		return "copilot_instance_id"
 	default:
 		return "unspecified"
 	}
@@ -473,6 +874,28 @@ const (
 	SecurityAccess_PUBLIC      SecurityAccess = 2 // use slb
 )
 
+func (sa SecurityAccess) String() string {
+	switch sa {
+	case SecurityAccess_PRIVATE:
+		return "private"
+	case SecurityAccess_PUBLIC:
+		return "public"
+	default:
+		return "unspecified"
+	}
+}
+
+func SecurityAccessFromString(s string) SecurityAccess {
+	switch s {
+	case "private":
+		return SecurityAccess_PRIVATE
+	case "public":
+		return SecurityAccess_PUBLIC
+	default:
+		return SecurityAccess_UNSPECIFIED
+	}
+}
+
 type NodeErrorType int32
 
 const (
@@ -481,36 +904,61 @@ const (
 	NodeErrorType_CLUSTER_ERROR        NodeErrorType = 2
 )
 
+func (net NodeErrorType) String() string {
+	switch net {
+	case NodeErrorType_INFRASTRUCTURE_ERROR:
+		return "infrastructure_error"
+	case NodeErrorType_CLUSTER_ERROR:
+		return "cluster_error"
+	default:
+		return "unspecified"
+	}
+}
+
 type Cluster struct {
-	Id                int64            `gorm:"column:id;primaryKey;AUTO_INCREMENT" json:"id,omitempty"`
-	Name              string           `gorm:"column:name;default:'';NOT NULL" json:"name,omitempty"`
-	ApiServerAddress  string           `gorm:"column:api_server_address;default:'';NOT NULL" json:"api_server_address,omitempty"`
-	KubernetesVersion string           `gorm:"column:kubernetes_version;default:'';NOT NULL" json:"kubernetes_version,omitempty"`
-	ImageRepository   string           `gorm:"column:image_repository;default:'';NOT NULL" json:"image_repository,omitempty"`
-	Config            string           `gorm:"column:config;default:'';NOT NULL" json:"config,omitempty"`
-	Status            ClusterStatus    `gorm:"column:status;default:0;NOT NULL" json:"status,omitempty"`
-	Provider          ClusterProvider  `gorm:"column:provider;default:0;NOT NULL" json:"provider,omitempty"`
-	Level             ClusterLevel     `gorm:"column:level;default:0;NOT NULL" json:"level,omitempty"`
-	PublicKey         string           `gorm:"column:public_key;default:'';NOT NULL" json:"public_key,omitempty"`
-	PrivateKey        string           `gorm:"column:private_key;default:'';NOT NULL" json:"private_key,omitempty"`
-	Region            string           `gorm:"column:region;default:'';NOT NULL" json:"region,omitempty"`
-	UserId            int64            `gorm:"column:user_id;default:0;NOT NULL" json:"user_id,omitempty"` // action user
-	AccessId          string           `gorm:"column:access_id;default:'';NOT NULL" json:"access_id,omitempty"`
-	AccessKey         string           `gorm:"column:access_key;default:'';NOT NULL" json:"access_key,omitempty"`
-	NodeUsername      string           `gorm:"column:node_username;default:'';NOT NULL" json:"node_username,omitempty"`
-	NodeStartIp       string           `gorm:"column:node_start_ip;default:'';NOT NULL" json:"node_start_ip,omitempty"`
-	NodeEndIp         string           `gorm:"column:node_end_ip;default:'';NOT NULL" json:"node_end_ip,omitempty"`
-	Domain            string           `gorm:"column:domain;default:'';NOT NULL" json:"domain,omitempty"`
-	VpcCidr           string           `gorm:"column:vpc_cidr;default:'';NOT NULL" json:"vpc_cidr,omitempty"`
-	ServiceCidr       string           `gorm:"column:service_cidr;default:'';NOT NULL" json:"service_cidr,omitempty"`
-	PodCidr           string           `gorm:"column:pod_cidr;default:'';NOT NULL" json:"pod_cidr,omitempty"`
-	SubnetCidrs       string           `gorm:"column:subnet_cidrs;default:'';NOT NULL" json:"subnet_cidrs,omitempty"` // 多个子网cidr，逗号分隔
-	GatewayClass      string           `gorm:"column:gateway_class;default:'';NOT NULL" json:"gateway_class,omitempty"`
-	StorageClass      string           `gorm:"column:storage_class;default:'';NOT NULL" json:"storage_class,omitempty"`
-	NodeGroups        []*NodeGroup     `gorm:"-" json:"node_groups,omitempty"`
-	Nodes             []*Node          `gorm:"-" json:"nodes,omitempty"`
-	CloudResources    []*CloudResource `gorm:"-" json:"cloud_resources,omitempty"`
-	Securitys         []*Security      `gorm:"-" json:"securitys,omitempty"`
+	Id                int64               `gorm:"column:id;primaryKey;AUTO_INCREMENT" json:"id,omitempty"`
+	Name              string              `gorm:"column:name;default:'';NOT NULL" json:"name,omitempty"`
+	ApiServerAddress  string              `gorm:"column:api_server_address;default:'';NOT NULL" json:"api_server_address,omitempty"`
+	ApiServerVip      string              `gorm:"column:api_server_vip;default:'';NOT NULL" json:"api_server_vip,omitempty"` // bare metal control plane virtual ip
+	KubernetesVersion string              `gorm:"column:kubernetes_version;default:'';NOT NULL" json:"kubernetes_version,omitempty"`
+	UpgradeVersion    string              `gorm:"column:upgrade_version;default:'';NOT NULL" json:"upgrade_version,omitempty"`        // target of a running upgrade
+	RestoreSnapshotId int64               `gorm:"column:restore_snapshot_id;default:0;NOT NULL" json:"restore_snapshot_id,omitempty"` // etcd snapshot of a running restore
+	ImageRepository   string              `gorm:"column:image_repository;default:'';NOT NULL" json:"image_repository,omitempty"`
+	Config            string              `gorm:"column:config;default:'';NOT NULL" json:"config,omitempty"`
+	Status            ClusterStatus       `gorm:"column:status;default:0;NOT NULL" json:"status,omitempty"`
+	Provider          ClusterProvider     `gorm:"column:provider;default:0;NOT NULL" json:"provider,omitempty"`
+	Level             ClusterLevel        `gorm:"column:level;default:0;NOT NULL" json:"level,omitempty"`
+	Distribution      ClusterDistribution `gorm:"column:distribution;default:0;NOT NULL" json:"distribution,omitempty"`
+	PublicKey         string              `gorm:"column:public_key;default:'';NOT NULL" json:"public_key,omitempty"`
+	PrivateKey        string              `gorm:"column:private_key;default:'';NOT NULL" json:"private_key,omitempty"`
+	Region            string              `gorm:"column:region;default:'';NOT NULL" json:"region,omitempty"`
+	UserId            int64               `gorm:"column:user_id;default:0;NOT NULL" json:"user_id,omitempty"` // action user
+	AccessId          string              `gorm:"column:access_id;default:'';NOT NULL" json:"access_id,omitempty"`
+	AccessKey         string              `gorm:"column:access_key;default:'';NOT NULL" json:"access_key,omitempty"`
+	NodeUsername      string              `gorm:"column:node_username;default:'';NOT NULL" json:"node_username,omitempty"`
+	NodeStartIp       string              `gorm:"column:node_start_ip;default:'';NOT NULL" json:"node_start_ip,omitempty"`
+	NodeEndIp         string              `gorm:"column:node_end_ip;default:'';NOT NULL" json:"node_end_ip,omitempty"`
+	ProxyJump         string              `gorm:"column:proxy_jump;default:'';NOT NULL" json:"proxy_jump,omitempty"`             // jump hosts the nodes are reached through in ssh ProxyJump syntax, eg: ops@bastion:2222,ops@10.0.0.5
+	JumpPrivateKey    string              `gorm:"column:jump_private_key;default:'';NOT NULL" json:"jump_private_key,omitempty"` // private key of the jump hosts, the cluster private key is used when empty
+	JumpHostKeys      string              `gorm:"column:jump_host_keys;default:'';NOT NULL" json:"jump_host_keys,omitempty"`     // pinned jump host keys, one "host:port key" per line
+	Domain            string              `gorm:"column:domain;default:'';NOT NULL" json:"domain,omitempty"`
+	VpcCidr           string              `gorm:"column:vpc_cidr;default:'';NOT NULL" json:"vpc_cidr,omitempty"`
+	ServiceCidr       string              `gorm:"column:service_cidr;default:'';NOT NULL" json:"service_cidr,omitempty"`
+	PodCidr           string              `gorm:"column:pod_cidr;default:'';NOT NULL" json:"pod_cidr,omitempty"`
+	SubnetCidrs       string              `gorm:"column:subnet_cidrs;default:'';NOT NULL" json:"subnet_cidrs,omitempty"` // 多个子网cidr，逗号分隔
+	GatewayClass      string              `gorm:"column:gateway_class;default:'';NOT NULL" json:"gateway_class,omitempty"`
+	StorageClass      string              `gorm:"column:storage_class;default:'';NOT NULL" json:"storage_class,omitempty"`
+	Cni               string              `gorm:"column:cni;default:'';NOT NULL" json:"cni,omitempty"`
+	ExternallyManaged bool                `gorm:"column:externally_managed;default:false;NOT NULL" json:"externally_managed,omitempty"` // imported, its infrastructure is never touched
+	KubeConfig        string              `gorm:"column:kube_config;default:'';NOT NULL" json:"-"`
+	CertsCheckedAt    string              `gorm:"column:certs_checked_at;default:'';NOT NULL" json:"certs_checked_at,omitempty"` // last certificate expiry collection
+	NodeGroups        []*NodeGroup        `gorm:"-" json:"node_groups,omitempty"`
+	Nodes             []*Node             `gorm:"-" json:"nodes,omitempty"`
+	CloudResources    []*CloudResource    `gorm:"-" json:"cloud_resources,omitempty"`
+	Securitys         []*Security         `gorm:"-" json:"securitys,omitempty"`
+	InventoryHosts    []*InventoryHost    `gorm:"-" json:"inventory_hosts,omitempty"`
+	NodeCredentials   []*NodeCredential   `gorm:"-" json:"node_credentials,omitempty"`
+	InstanceId        string              `gorm:"-" json:"-"` // id of the cloud-copilot installation managing the cluster
 }
 
 type NodeGroup struct {
@@ -549,6 +997,10 @@ type Node struct {
 	NodeInfo          string        `gorm:"column:node_info;default:'';NOT NULL" json:"node_info,omitempty"`
 	ErrorType         NodeErrorType `gorm:"column:error_type;default:0;NOT NULL" json:"error_type,omitempty"`
 	ErrorMessage      string        `gorm:"column:error_message;default:'';NOT NULL" json:"error_message,omitempty"`
+	ErrorStatus       NodeStatus    `gorm:"column:error_status;default:0;NOT NULL" json:"error_status,omitempty"`         // status the node failed in, RetryNode resumes from it
+	HostKey           string        `gorm:"column:host_key;default:'';NOT NULL" json:"host_key,omitempty"`                // pinned ssh host key in authorized_keys format
+	Preflight         string        `gorm:"column:preflight;default:'';NOT NULL" json:"preflight,omitempty"`              // checks of the last preflight in json
+	NotReadyProbes    int32         `gorm:"column:not_ready_probes;default:0;NOT NULL" json:"not_ready_probes,omitempty"` // consecutive not ready probes of a running master
 }
 
 type Disk struct {
@@ -588,25 +1040,74 @@ type ClusterData interface {
 	Get(context.Context, int64) (*Cluster, error)
 	GetByName(context.Context, string) (*Cluster, error)
 	GetClustersByIds(context.Context, []int64) ([]*Cluster, error)
+	GetInstanceId() string
 	List(ctx context.Context, name string, page, pageSize int32) ([]*Cluster, int64, error)
 	Delete(context.Context, int64) error
 	RegisterHandlerClusterEvent(handler func(ctx context.Context, cluster *Cluster) error)
 	RegisterHandlerLogs(handler func(ctx context.Context, key LogType, msg string) error)
+	RegisterHandlerClusterCheck(handler func(ctx context.Context, cluster *Cluster) error)
 	Apply(context.Context, *Cluster) error
 	CommitLogs(context.Context, LogType, string) error
+	SaveEvent(context.Context, *Event) error
+	ListEvents(context.Context, *EventFilter) ([]*Event, int64, error)
+	GetCheckpoints(ctx context.Context, clusterId int64) ([]*ClusterCheckpoint, error)
+	SaveCheckpoint(context.Context, *ClusterCheckpoint) error
+	DeleteCheckpoints(ctx context.Context, clusterId int64) error
+	GetEtcdBackupPolicy(ctx context.Context, clusterId int64) (*EtcdBackupPolicy, error)
+	SaveEtcdBackupPolicy(context.Context, *EtcdBackupPolicy) error
+	ListEtcdSnapshots(ctx context.Context, clusterId int64) ([]*EtcdSnapshot, error)
+	GetEtcdSnapshot(ctx context.Context, id int64) (*EtcdSnapshot, error)
+	SaveEtcdSnapshot(context.Context, *EtcdSnapshot) error
+	DeleteEtcdSnapshot(ctx context.Context, id int64) error
+	GetCloudDriftReport(ctx context.Context, clusterId int64) (*CloudDriftReport, error)
+	SaveCloudDriftReport(context.Context, *CloudDriftReport) error
+	ListCertificates(ctx context.Context, clusterId int64) ([]*Certificate, error)
+	SaveCertificates(context.Context, *Cluster, []*Certificate) error
+	SaveInventory(context.Context, *Cluster) error
 }
 
 type ClusterInfrastructure interface {
 	GetRegions(ctx context.Context, provider ClusterProvider, accessId, accessKey string) ([]*CloudResource, error)
 	GetZones(context.Context, *Cluster) ([]*CloudResource, error)
 	ManageCloudBasicResource(context.Context, *Cluster) error
+	CreateNetwork(context.Context, *Cluster) error
+	ImportKeyPair(context.Context, *Cluster) error
 	DeleteCloudBasicResource(context.Context, *Cluster) error
 	ManageNodeResource(context.Context, *Cluster) error
+	ManageSecurityGroup(context.Context, *Cluster) error
+	ManageInstance(context.Context, *Cluster) error
+	ManageSLB(context.Context, *Cluster) error
 	GetNodesSystemInfo(context.Context, *Cluster) error
 	Install(context.Context, *Cluster) error
+	InitControlPlane(context.Context, *Cluster) error
+	JoinNodes(context.Context, *Cluster) error
+	RemoveEtcdMember(context.Context, *Cluster, *Node) error
+	SaveEtcdSnapshot(context.Context, *Cluster, *EtcdBackupPolicy, *EtcdSnapshot) error
+	VerifyEtcdSnapshot(context.Context, *Cluster, *EtcdBackupPolicy, *EtcdSnapshot) error
+	RestoreEtcdSnapshot(context.Context, *Cluster, *EtcdBackupPolicy, *EtcdSnapshot) error
+	DeleteEtcdSnapshot(context.Context, *EtcdBackupPolicy, *EtcdSnapshot) error
+	ValidateKubernetesUpgrade(ctx context.Context, cluster *Cluster, version string) error
+	UpgradeNode(ctx context.Context, cluster *Cluster, node *Node, version string) error
 	UnInstall(context.Context, *Cluster) error
 	HandlerNodes(context.Context, *Cluster) error
 	WaitClusterSlbReady(context.Context, *Cluster) error
+	CreateKindCluster(context.Context, *Cluster) error
+	StopKindCluster(context.Context, *Cluster) error
+	DeleteKindCluster(context.Context, *Cluster) error
+	LoadKindImage(ctx context.Context, cluster *Cluster, image string) error
+	GetKubeConfig(context.Context, *Cluster) (string, error)
+	ValidateNodeGroup(context.Context, *Cluster, *NodeGroup) error
+	GetNodeSystemInfo(context.Context, *Cluster, *Node) (*NodeSystemInfo, error)
+	RebootNode(context.Context, *Cluster, *Node) error
+	ReinitNode(context.Context, *Cluster, *Node) error
+	ScanNodeHostKey(context.Context, *Cluster, *Node) (string, error)
+	PreflightNodes(context.Context, *Cluster, []*Node) error
+	GetNodeCertificates(context.Context, *Cluster, *Node) ([]*Certificate, error)
+	RotateNodeCertificates(context.Context, *Cluster, *Node) error
+	DetectCloudDrift(context.Context, *Cluster) ([]*CloudDrift, error)
+	RepairCloudDrift(context.Context, *Cluster, []*CloudDrift) error
+	ListTaggedCloudResources(ctx context.Context, provider ClusterProvider, accessId, accessKey, region string) ([]*OrphanedCloudResource, error)
+	DeleteOrphanedCloudResources(context.Context, *Cluster) error
 }
 
 type ClusterRuntime interface {
@@ -614,6 +1115,13 @@ type ClusterRuntime interface {
 	ReloadCluster(context.Context, *Cluster) error
 	Install(context.Context, *Cluster) error
 	ClusterIsExist(ctx context.Context) bool
+	DrainNode(ctx context.Context, node *Node, timeout time.Duration) error
+	UncordonNode(context.Context, *Node) error
+	DeleteNode(context.Context, *Node) error
+	WaitNodeReady(ctx context.Context, node *Node, version string, timeout time.Duration) error
+	IsNodeReady(context.Context, *Node) (bool, error)
+	DiscoverCluster(context.Context, *Cluster) error
+	InvalidateClusterClient(clusterId int64)
 }
 
 func WithCluster(ctx context.Context, cluster *Cluster) context.Context {
@@ -646,6 +1154,7 @@ func NewClusterUseCase(ctx context.Context, conf *confPkg.Bootstrap, clusterData
 	}
 	clusterUc.clusterData.RegisterHandlerClusterEvent(clusterUc.HandleClusterEvent)
 	clusterUc.clusterData.RegisterHandlerLogs(clusterUc.Handlerlogs)
+	clusterUc.clusterData.RegisterHandlerClusterCheck(clusterUc.CheckCluster)
 
 	if clusterUc.conf.Infrastructure.Cluster == "" {
 		return clusterUc, nil
@@ -706,7 +1215,97 @@ func (c *Cluster) GetSingleMasterNode() *Node {
 	return nil
 }
 
+// ControlPlaneNumber is the number of masters the cluster level asks for, stacked etcd keeps it odd,
+// bare metal clusters need a virtual ip to run more than one and kind clusters always run one
+func (c *Cluster) ControlPlaneNumber() int {
+	if c.Provider == ClusterProvider_Kind || (!c.Provider.IsCloud() && c.ApiServerVip == "") {
+		return 1
+	}
+	switch c.Level {
+	case ClusterLevel_ADVANCED:
+		return 3
+	case ClusterLevel_STANDARD:
+		return 5
+	default:
+		return 1
+	}
+}
+
+func (c *Cluster) IsHighAvailability() bool {
+	return c.ControlPlaneNumber() > 1
+}
+
+func (c *Cluster) GetMasterNodes() []*Node {
+	nodes := make([]*Node, 0)
+	for _, node := range c.Nodes {
+		if node.Role == NodeRole_MASTER && !node.DeleteNode() {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// GetLostMasterNodes returns the running masters the cluster check found not ready,
+// masters whose node operation failed wait for RetryNode instead
+func (c *Cluster) GetLostMasterNodes() []*Node {
+	nodes := make([]*Node, 0)
+	for _, node := range c.GetMasterNodes() {
+		if node.Status == NodeStatus_NODE_ERROR && node.ErrorType == NodeErrorType_CLUSTER_ERROR && node.ErrorStatus == NodeStatus_NODE_RUNNING {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// SetControlPlaneNodes grows the masters to ControlPlaneNumber, bare metal promotes machines that are not installed yet
+// and cloud clusters add new master nodes to the first node group
+func (c *Cluster) SetControlPlaneNodes() {
+	need := c.ControlPlaneNumber() - len(c.GetMasterNodes())
+	if need <= 0 {
+		return
+	}
+	if !c.Provider.IsCloud() {
+		for _, node := range c.Nodes {
+			if need <= 0 {
+				return
+			}
+			if node.Role != NodeRole_WORKER || (node.Status != NodeStatus_NODE_FINDING && node.Status != NodeStatus_NODE_CREATING) {
+				continue
+			}
+			node.Role = NodeRole_MASTER
+			need--
+		}
+		return
+	}
+	if len(c.NodeGroups) == 0 {
+		return
+	}
+	nodeGroup := c.NodeGroups[0]
+	for range make([]struct{}, need) {
+		c.AddNode(&Node{
+			Name:        fmt.Sprintf("%s-%s", c.Name, uuid.NewString()),
+			Role:        NodeRole_MASTER,
+			Status:      NodeStatus_NODE_FINDING,
+			ClusterId:   c.Id,
+			NodeGroupId: nodeGroup.Id,
+		})
+		nodeGroup.SetTargetSize(nodeGroup.TargetSize + 1)
+	}
+}
+
+// SetApiServerAddress points the control plane endpoint at the load balancer or virtual ip of a highly
+// available cluster, and at the single master otherwise
 func (c *Cluster) SetApiServerAddress() {
+	if c.IsHighAvailability() {
+		if c.ApiServerVip != "" {
+			c.ApiServerAddress = c.ApiServerVip
+			return
+		}
+		if slb := c.GetSingleCloudResource(ResourceType_LOAD_BALANCER); slb != nil && slb.Value != "" {
+			c.ApiServerAddress = slb.Value
+			return
+		}
+	}
 	node := c.GetSingleMasterNode()
 	if node == nil {
 		return
@@ -916,7 +1515,7 @@ func (c *Cluster) DeleteCloudResourceByTags(resourceType ResourceType, tagKeyVal
 }
 
 func (c ClusterProvider) IsCloud() bool {
-	return c != ClusterProvider_BareMetal
+	return c == ClusterProvider_Aws || c == ClusterProvider_AliCloud
 }
 
 func (c *Cluster) GetNodeGroup(nodeGroupId string) *NodeGroup {
@@ -957,9 +1556,10 @@ func (c *Cluster) GetNodeByIp(ip string) *Node {
 }
 
 func (c *Cluster) DistributeNodePrivateSubnets(nodeIndex int) *CloudResource {
-	tags := c.GetTags()
-	tags[ResourceTypeKeyValue_ACCESS] = ResourceTypeKeyValue_ACCESS_PRIVATE
-	subnets := c.GetCloudResourceByTags(ResourceType_SUBNET, tags)
+	// only the access tag is matched, subnets recorded before the ownership tags existed carry no others
+	subnets := c.GetCloudResourceByTags(ResourceType_SUBNET, map[ResourceTypeKeyValue]any{
+		ResourceTypeKeyValue_ACCESS: ResourceTypeKeyValue_ACCESS_PRIVATE,
+	})
 	if len(subnets) == 0 {
 		return nil
 	}
@@ -972,8 +1572,18 @@ func (c *Cluster) DistributeNodePrivateSubnets(nodeIndex int) *CloudResource {
 	return subnets[(nodeIndex/interval)%subnetsSize]
 }
 
+// GetTags are the tags every cloud resource of the cluster carries, the garbage collector finds orphaned resources by them
+// and only collects the ones tagged with its own instance id
 func (c *Cluster) GetTags() map[ResourceTypeKeyValue]any {
-	return make(map[ResourceTypeKeyValue]any)
+	tags := map[ResourceTypeKeyValue]any{
+		ResourceTypeKeyValue_MANAGED_BY:   CloudResourceManagedBy,
+		ResourceTypeKeyValue_CLUSTER_ID:   c.Id,
+		ResourceTypeKeyValue_CLUSTER_NAME: c.Name,
+	}
+	if c.InstanceId != "" {
+		tags[ResourceTypeKeyValue_INSTANCE_ID] = c.InstanceId
+	}
+	return tags
 }
 
 func (c *Cluster) CreateCluster() bool {
@@ -1044,26 +1654,40 @@ func (c *Cluster) GetLabels() map[string]string {
 	}
 }
 
+// SetCidr generates the cidrs left empty, the ones set by a cluster spec are kept
 func (c *Cluster) SetCidr() (err error) {
-	c.VpcCidr, err = utils.GenerateClusterCIDR(c.Id)
-	if err != nil {
-		return
+	if c.VpcCidr == "" {
+		c.VpcCidr, err = utils.GenerateClusterCIDR(c.Id)
+		if err != nil {
+			return
+		}
 	}
-	kubernetesCIDRs, err := utils.GenerateKubernetesCIDRs(c.Id, c.VpcCidr)
-	if err != nil {
-		return
+	if c.PodCidr == "" || c.ServiceCidr == "" {
+		kubernetesCIDRs, cidrErr := utils.GenerateKubernetesCIDRs(c.Id, c.VpcCidr)
+		if cidrErr != nil {
+			return cidrErr
+		}
+		if c.PodCidr == "" {
+			c.PodCidr = kubernetesCIDRs.PodCIDR
+		}
+		if c.ServiceCidr == "" {
+			c.ServiceCidr = kubernetesCIDRs.ServiceCIDR
+		}
 	}
-	c.PodCidr = kubernetesCIDRs.PodCIDR
-	c.ServiceCidr = kubernetesCIDRs.ServiceCIDR
-	subnetCidr, err := utils.GenerateSubnets(c.VpcCidr, 10)
-	if err != nil {
-		return
+	if c.SubnetCidrs == "" {
+		subnetCidr, subnetErr := utils.GenerateSubnets(c.VpcCidr, 10)
+		if subnetErr != nil {
+			return subnetErr
+		}
+		c.SubnetCidrs = strings.Join(subnetCidr, ",")
 	}
-	c.SubnetCidrs = strings.Join(subnetCidr, ",")
 	return
 }
 
 func (c *Cluster) SetDomain() {
+	if c.Domain != "" {
+		return
+	}
 	c.Domain = fmt.Sprintf("cluster-%s.svc", c.Name)
 }
 
@@ -1124,18 +1748,36 @@ func (c *Cluster) SetZoneByLevel(zones []*CloudResource) {
 	}
 }
 
+// SetBareMetalNode adds the inventory hosts, or the ips of the node range without an inventory, as finding nodes
+// and marks the nodes no longer declared deleting
 func (c *Cluster) SetBareMetalNode() {
-	nodeIps := utils.RangeIps(c.NodeStartIp, c.NodeEndIp)
-	for i, nodeIp := range nodeIps {
-		if c.GetNodeByIp(nodeIp) != nil {
+	hosts := c.InventoryHosts
+	if len(hosts) == 0 {
+		hosts = make([]*InventoryHost, 0)
+		for _, nodeIp := range utils.RangeIps(c.NodeStartIp, c.NodeEndIp) {
+			hosts = append(hosts, &InventoryHost{Host: nodeIp})
+		}
+	}
+	nodeIps := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		nodeIps = append(nodeIps, host.Host)
+		if c.GetNodeByIp(host.Host) != nil {
 			continue
 		}
+		username := host.Username
+		if username == "" {
+			username = c.NodeUsername
+		}
+		role := host.Role
+		if role == NodeRole_UNSPECIFIED {
+			role = NodeRole_WORKER
+		}
 		c.Nodes = append(c.Nodes, &Node{
-			Name:      fmt.Sprintf("node%d", i+1),
-			Ip:        nodeIp,
-			Username:  c.NodeUsername,
+			Name:      c.newBareMetalNodeName(),
+			Ip:        host.Host,
+			Username:  username,
 			Status:    NodeStatus_NODE_FINDING,
-			Role:      NodeRole_WORKER,
+			Role:      role,
 			ClusterId: c.Id,
 		})
 	}
@@ -1156,7 +1798,34 @@ func (c *Cluster) SetBareMetalNode() {
 	}
 }
 
+// newBareMetalNodeName returns the first nodeN name no node uses, ips of an inventory are not contiguous
+func (c *Cluster) newBareMetalNodeName() string {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("node%d", i)
+		if !slices.ContainsFunc(c.Nodes, func(node *Node) bool { return node.Name == name }) {
+			return name
+		}
+	}
+}
+
+// InitCloudNodeAndNodeGroup adds the default node group to a cluster without node groups,
+// defined node groups get nodes up to their target size and the first new node becomes the master
 func (c *Cluster) InitCloudNodeAndNodeGroup() {
+	if len(c.NodeGroups) != 0 {
+		for _, nodeGroup := range c.NodeGroups {
+			c.SyncNodeGroupSize(nodeGroup)
+		}
+		if len(c.GetMasterNodes()) != 0 {
+			return
+		}
+		for _, node := range c.Nodes {
+			if node.Status == NodeStatus_NODE_FINDING && node.NodeGroupId == c.NodeGroups[0].Id {
+				node.Role = NodeRole_MASTER
+				return
+			}
+		}
+		return
+	}
 	targetNodeSize := int32(3)
 	nodeGroup := &NodeGroup{
 		Id:         uuid.NewString(),
@@ -1254,6 +1923,33 @@ func (c *Cluster) SetNodeStatus(status NodeStatus) {
 	}
 }
 
+func (c *Cluster) HasDeletingNode() bool {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_DELETING {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Cluster) HasPendingNode() bool {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_PENDING {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Cluster) HasFindingNode() bool {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_FINDING {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Cluster) DeleteNode(node *Node) {
 	for i, v := range c.Nodes {
 		if v.Ip == node.Ip {
@@ -1356,6 +2052,20 @@ func (n *Node) SetStatus(status NodeStatus) {
 	n.Status = status
 }
 
+// SetError parks the node in error and keeps the status it failed in for RetryNode
+func (n *Node) SetError(errorType NodeErrorType, err error) {
+	n.ErrorStatus = n.Status
+	n.ErrorType = errorType
+	n.ErrorMessage = err.Error()
+	n.Status = NodeStatus_NODE_ERROR
+}
+
+func (n *Node) ClearError() {
+	n.ErrorStatus = NodeStatus_UNSPECIFIED
+	n.ErrorType = NodeErrorType_UNSPECIFIED
+	n.ErrorMessage = ""
+}
+
 func (n *Node) AddDisk(disk *Disk) {
 	if n.Disks == nil {
 		n.Disks = make([]*Disk, 0)
@@ -1377,16 +2087,24 @@ func (uc *ClusterUsecase) NodeGroupIncreaseSize(ctx context.Context, cluster *Cl
 	return nil
 }
 
+// DeleteNodes marks the nodes for removal and shrinks their node groups,
+// the cluster event drains them before the instances are reset and released
 func (uc *ClusterUsecase) DeleteNodes(ctx context.Context, cluster *Cluster, nodes []*Node) error {
 	for _, node := range nodes {
-		for i, n := range cluster.Nodes {
-			if n.Id == node.Id {
-				cluster.Nodes = append(cluster.Nodes[:i], cluster.Nodes[i+1:]...)
-				break
-			}
+		if node.DeleteNode() {
+			continue
+		}
+		node.SetStatus(NodeStatus_NODE_DELETING)
+		nodeGroup := cluster.GetNodeGroup(node.NodeGroupId)
+		if nodeGroup != nil && nodeGroup.TargetSize > 0 {
+			nodeGroup.SetTargetSize(nodeGroup.TargetSize - 1)
 		}
 	}
-	return nil
+	err := uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
 }
 
 func (uc *ClusterUsecase) NodeGroupTemplateNodeInfo(ctx context.Context, cluster *Cluster, nodeGroup *NodeGroup) (*Node, error) {
@@ -1432,6 +2150,8 @@ func (uc *ClusterUsecase) GetClusterStatus() []ClusterStatus {
 		ClusterStatus_STOPPING,
 		ClusterStatus_STOPPED,
 		ClusterStatus_DELETED,
+		ClusterStatus_UPGRADING,
+		ClusterStatus_RESTORING,
 	}
 }
 
@@ -1440,6 +2160,7 @@ func (uc *ClusterUsecase) GetClusterProviders() []ClusterProvider {
 		ClusterProvider_BareMetal,
 		ClusterProvider_Aws,
 		ClusterProvider_AliCloud,
+		ClusterProvider_Kind,
 	}
 }
 
@@ -1469,6 +2190,10 @@ func (uc *ClusterUsecase) GetNodeStatuses() []NodeStatus {
 		NodeStatus_NODE_DELETING,
 		NodeStatus_NODE_DELETED,
 		NodeStatus_NODE_ERROR,
+		NodeStatus_NODE_REBOOTING,
+		NodeStatus_NODE_REINITIALIZING,
+		NodeStatus_NODE_REPLACING,
+		NodeStatus_NODE_ROTATING_CERTS,
 	}
 }
 
@@ -1535,16 +2260,30 @@ func (uc *ClusterUsecase) Delete(ctx context.Context, clusterID int64) error {
 	if cluster.Status == ClusterStatus_RUNNING {
 		return errors.New("cluster is running")
 	}
-	return uc.clusterData.Delete(ctx, clusterID)
+	if cluster.Provider == ClusterProvider_Kind && !cluster.ExternallyManaged {
+		err = uc.clusterInfrastructure.DeleteKindCluster(ctx, cluster)
+		if err != nil {
+			return err
+		}
+	}
+	err = uc.clusterData.Delete(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	uc.clusterRuntime.InvalidateClusterClient(clusterID)
+	return nil
 }
 
 func (uc *ClusterUsecase) Save(ctx context.Context, cluster *Cluster) error {
 	if cluster.Status == ClusterStatus_UNSPECIFIED {
 		cluster.SetStatus(ClusterStatus_CREATING)
 	}
-	if cluster.Status == ClusterStatus_CREATING {
+	if cluster.Status == ClusterStatus_CREATING && cluster.Level == ClusterLevel_UNSPECIFIED {
 		cluster.SetLevel(ClusterLevel_BASIC)
 	}
+	if cluster.Distribution == ClusterDistribution_UNSPECIFIED {
+		cluster.SetDistribution(ClusterDistribution_KUBEADM)
+	}
 	return uc.clusterData.Save(ctx, cluster)
 }
 
@@ -1555,6 +2294,23 @@ func (uc *ClusterUsecase) GetRegions(ctx context.Context, provider ClusterProvid
 	return uc.clusterInfrastructure.GetRegions(ctx, provider, accessId, accessKey)
 }
 
+// prepareStart fills in the desired state a start provisions, it makes no cloud calls
+func (c *Cluster) prepareStart() {
+	c.SetCidr()
+	c.SetDomain()
+	if c.Provider.IsCloud() {
+		c.InitCloudNodeAndNodeGroup()
+		if len(c.Securitys) == 0 {
+			c.InitSecuritys()
+		}
+	} else if c.Provider == ClusterProvider_Kind {
+		c.SetKindNodes()
+	} else {
+		c.SetBareMetalNode()
+	}
+	c.SetControlPlaneNodes()
+}
+
 func (uc *ClusterUsecase) StartCluster(ctx context.Context, clusterId int64) error {
 	cluster, err := uc.Get(ctx, clusterId)
 	if err != nil {
@@ -1563,13 +2319,12 @@ func (uc *ClusterUsecase) StartCluster(ctx context.Context, clusterId int64) err
 	if cluster.IsEmpty() {
 		return nil
 	}
-	cluster.SetCidr()
-	cluster.SetDomain()
-	if cluster.Provider.IsCloud() {
-		cluster.InitCloudNodeAndNodeGroup()
-		cluster.InitSecuritys()
-	} else {
-		cluster.SetBareMetalNode()
+	if cluster.Provider == ClusterProvider_BareMetal && !cluster.ExternallyManaged &&
+		len(cluster.InventoryHosts) == 0 && (cluster.NodeStartIp == "" || cluster.NodeEndIp == "") {
+		return errors.New("a bare metal cluster needs an inventory or a node ip range to start")
+	}
+	if !cluster.ExternallyManaged {
+		cluster.prepareStart()
 	}
 	cluster.SetStatus(ClusterStatus_STARTING)
 	err = uc.clusterData.Save(ctx, cluster)
@@ -1603,13 +2358,135 @@ func (uc *ClusterUsecase) StopCluster(ctx context.Context, clusterId int64) erro
 	return nil
 }
 
-func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Cluster) (err error) {
-	defer func() {
-		if err != nil {
-			cluster.SetStatus(ClusterStatus_ERROR)
-		}
+// UpgradeCluster queues a kubernetes version upgrade of a running cluster
+func (uc *ClusterUsecase) UpgradeCluster(ctx context.Context, clusterId int64, version string) error {
+	cluster, err := uc.Get(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	if cluster.IsEmpty() {
+		return errors.New("cluster not found")
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return errors.New("only running clusters can be upgraded")
+	}
+	if cluster.Distribution == ClusterDistribution_K3S {
+		return errors.New("k3s clusters are upgraded with the k3s installer, not kubeadm")
+	}
+	if cluster.Provider == ClusterProvider_Kind {
+		return errors.New("kind clusters are upgraded by recreating them with a newer node image")
+	}
+	if cluster.ExternallyManaged {
+		return errors.New("imported clusters are upgraded by their own tooling")
+	}
+	err = uc.clusterInfrastructure.ValidateKubernetesUpgrade(ctx, cluster, version)
+	if err != nil {
+		return err
+	}
+	cluster.UpgradeVersion = version
+	cluster.SetStatus(ClusterStatus_UPGRADING)
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+// upgradeCluster upgrades the control plane first and then the workers one at a time,
+// it aborts at the first node that does not come back ready
+func (uc *ClusterUsecase) upgradeCluster(ctx context.Context, cluster *Cluster) error {
+	version := cluster.UpgradeVersion
+	if version == "" {
+		return errors.WithMessage(ErrClusterEventAborted, "upgrade version is empty")
+	}
+	nodes := make([]*Node, 0)
+	if masterNode := cluster.GetSingleMasterNode(); masterNode != nil {
+		nodes = append(nodes, masterNode)
+	}
+	for _, role := range []NodeRole{NodeRole_MASTER, NodeRole_WORKER, NodeRole_EDGE} {
+		for _, node := range cluster.Nodes {
+			if node.Role != role || slices.Contains(nodes, node) || node.Status != NodeStatus_NODE_RUNNING {
+				continue
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	for _, node := range nodes {
+		err := uc.recordStep(ctx, cluster, fmt.Sprintf("upgrade_node:%s", node.Name), func() error {
+			return uc.upgradeNode(ctx, cluster, node, version)
+		})
+		if err != nil {
+			return errors.WithMessagef(ErrClusterEventAborted, "upgrade node %s to %s failed: %v", node.Name, version, err)
+		}
+	}
+	cluster.KubernetesVersion = version
+	cluster.UpgradeVersion = ""
+	cluster.SetStatus(ClusterStatus_RUNNING)
+	return nil
+}
+
+func (uc *ClusterUsecase) upgradeNode(ctx context.Context, cluster *Cluster, node *Node, version string) error {
+	err := uc.clusterRuntime.DrainNode(ctx, node, NodeDrainTimeout)
+	if err != nil {
+		return err
+	}
+	err = uc.clusterInfrastructure.UpgradeNode(ctx, cluster, node, version)
+	if err != nil {
+		return err
+	}
+	err = uc.clusterRuntime.WaitNodeReady(ctx, node, version, NodeReadyTimeout)
+	if err != nil {
+		return err
+	}
+	return uc.clusterRuntime.UncordonNode(ctx, node)
+}
+
+func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Cluster) (err error) {
+	// runtime calls go to this cluster
+	ctx = WithCluster(ctx, cluster)
+	defer func() {
+		if err != nil {
+			cluster.SetStatus(ClusterStatus_ERROR)
+		}
 		_ = uc.clusterData.Save(ctx, cluster)
 	}()
+	if cluster.ExternallyManaged {
+		return uc.handleImportedCluster(ctx, cluster)
+	}
+	if cluster.Provider == ClusterProvider_Kind {
+		return uc.handleKindCluster(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_UPGRADING {
+		return uc.upgradeCluster(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RESTORING {
+		return uc.restoreEtcd(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && len(cluster.GetLostMasterNodes()) > 0 {
+		return uc.repairControlPlane(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && cluster.HasDeletingNode() {
+		return uc.removeNodes(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && cluster.HasFindingNode() {
+		return uc.addNodes(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && cluster.HasPendingNode() {
+		return uc.joinPendingNodes(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && cluster.HasOperatingNode() {
+		return uc.operateNodes(ctx, cluster)
+	}
+	if cluster.Status == ClusterStatus_RUNNING && cluster.HasRotatingCertsNode() {
+		return uc.rotateCertificates(ctx, cluster)
+	}
+	driftReport, err := uc.getRepairingCloudDrift(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if driftReport != nil {
+		return uc.repairCloudDrift(ctx, cluster, driftReport)
+	}
 	if cluster.Status == ClusterStatus_STOPPING {
 		for _, node := range cluster.Nodes {
 			if node.Status == NodeStatus_UNSPECIFIED || node.Status == NodeStatus_NODE_DELETED {
@@ -1621,20 +2498,28 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 		if err != nil {
 			return err
 		}
-		err = uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+		err = uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+			return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+		})
 		if err != nil {
 			return err
 		}
-		err = uc.clusterInfrastructure.UnInstall(ctx, cluster)
+		err = uc.recordStep(ctx, cluster, ClusterStepUnInstall, func() error {
+			return uc.clusterInfrastructure.UnInstall(ctx, cluster)
+		})
 		if err != nil {
 			return err
 		}
-		err = uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+		err = uc.recordStep(ctx, cluster, ClusterStepManageNodeResource, func() error {
+			return uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+		})
 		if err != nil {
 			return err
 		}
 		if cluster.Provider.IsCloud() {
-			err = uc.clusterInfrastructure.DeleteCloudBasicResource(ctx, cluster)
+			err = uc.recordStep(ctx, cluster, ClusterStepDeleteCloudBasicResource, func() error {
+				return uc.clusterInfrastructure.DeleteCloudBasicResource(ctx, cluster)
+			})
 			if err != nil {
 				return err
 			}
@@ -1642,7 +2527,7 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 		for _, node := range cluster.Nodes {
 			node.SetStatus(NodeStatus_NODE_DELETED)
 		}
-		return nil
+		return uc.clusterData.DeleteCheckpoints(ctx, cluster.Id)
 	}
 	if uc.clusterRuntime.ClusterIsExist(ctx) {
 		return uc.HandlerClusterNotInstalled(ctx, cluster)
@@ -1651,13 +2536,7 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 		cluster.SetStatus(ClusterStatus_STARTING)
 	}
 	if cluster.Provider.IsCloud() && cluster.SettingClusterLevelByNodeNumber() {
-		var zoneResources []*CloudResource
-		zoneResources, err = uc.clusterInfrastructure.GetZones(ctx, cluster)
-		if err != nil {
-			return err
-		}
-		cluster.SetZoneByLevel(zoneResources)
-		err = uc.clusterInfrastructure.ManageCloudBasicResource(ctx, cluster)
+		err = uc.manageCloudBasicResource(ctx, cluster)
 		if err != nil {
 			return err
 		}
@@ -1665,7 +2544,10 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 	if !cluster.Provider.IsCloud() {
 		cluster.SetBareMetalNode()
 	}
-	err = uc.clusterInfrastructure.GetNodesSystemInfo(ctx, cluster)
+	cluster.SetControlPlaneNodes()
+	err = uc.recordStep(ctx, cluster, ClusterStepGetNodesSystemInfo, func() error {
+		return uc.clusterInfrastructure.GetNodesSystemInfo(ctx, cluster)
+	})
 	if err != nil {
 		return err
 	}
@@ -1674,12 +2556,31 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 	if err != nil {
 		return err
 	}
-	err = uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+	err = uc.drainDeletingNodes(ctx, cluster)
 	if err != nil {
 		return err
 	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		// RetryNode needs a running cluster, the retried start creates the failed nodes again
+		cluster.ResumeFailedNodes(NodeStatus_NODE_CREATING)
+	}
+	err = uc.recordStep(ctx, cluster, ClusterStepManageNodeResource, func() error {
+		return uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		for _, node := range cluster.GetMasterNodes() {
+			if node.Status == NodeStatus_NODE_ERROR && node.ErrorStatus == NodeStatus_NODE_CREATING {
+				return errors.Errorf("control plane node %s could not be created: %s", node.Name, node.ErrorMessage)
+			}
+		}
+	}
 	cluster.SetNodeStatusFromTo(NodeStatus_NODE_CREATING, NodeStatus_NODE_PENDING)
-	err = uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	err = uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+		return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	})
 	if err != nil {
 		return err
 	}
@@ -1688,50 +2589,458 @@ func (uc *ClusterUsecase) HandleClusterEvent(ctx context.Context, cluster *Clust
 	return
 }
 
-func (uc *ClusterUsecase) HandlerClusterNotInstalled(ctx context.Context, cluster *Cluster) error {
-	if cluster.Status != ClusterStatus_STARTING {
+// CheckCluster runs periodically for running clusters
+func (uc *ClusterUsecase) CheckCluster(ctx context.Context, cluster *Cluster) error {
+	if cluster.Status != ClusterStatus_RUNNING || cluster.ExternallyManaged {
 		return nil
 	}
-	if cluster.Provider.IsCloud() && cluster.SettingClusterLevelByNodeNumber() {
-		zoneResources, err := uc.clusterInfrastructure.GetZones(ctx, cluster)
+	ctx = WithCluster(ctx, cluster)
+	// the checks are independent, a failing one must not skip the scheduled etcd snapshot
+	checks := []struct {
+		name  string
+		check func(context.Context, *Cluster) error
+	}{
+		{"control plane", uc.checkControlPlane},
+		{"cloud drift", uc.runScheduledCloudDriftCheck},
+		{"certificate", uc.runScheduledCertificateCheck},
+		{"etcd snapshot", uc.runScheduledEtcdSnapshot},
+	}
+	for _, c := range checks {
+		if err := c.check(ctx, cluster); err != nil {
+			uc.log.Errorf("cluster %s %s check failed: %v", cluster.Name, c.name, err)
+		}
+	}
+	return nil
+}
+
+// checkControlPlane marks masters of a highly available control plane as lost and queues a repair once they failed
+// ControlPlaneLostProbes probes in a row, a probe that can not reach the api server counts neither way
+func (uc *ClusterUsecase) checkControlPlane(ctx context.Context, cluster *Cluster) error {
+	if !cluster.IsHighAvailability() {
+		return nil
+	}
+	changed, lost := false, false
+	for _, node := range cluster.GetMasterNodes() {
+		if node.Status != NodeStatus_NODE_RUNNING {
+			continue
+		}
+		ready, err := uc.clusterRuntime.IsNodeReady(ctx, node)
+		if err != nil {
+			uc.log.Warnf("cluster %s control plane node %s probe failed: %v", cluster.Name, node.Name, err)
+			continue
+		}
+		if ready {
+			if node.NotReadyProbes != 0 {
+				node.NotReadyProbes = 0
+				changed = true
+			}
+			continue
+		}
+		node.NotReadyProbes++
+		changed = true
+		uc.log.Warnf("cluster %s control plane node %s is not ready, %d of %d probes", cluster.Name, node.Name, node.NotReadyProbes, ControlPlaneLostProbes)
+		if node.NotReadyProbes < ControlPlaneLostProbes {
+			continue
+		}
+		node.SetError(NodeErrorType_CLUSTER_ERROR, errors.Errorf("control plane node is not ready after %d probes", node.NotReadyProbes))
+		node.NotReadyProbes = 0
+		lost = true
+	}
+	if !changed {
+		return nil
+	}
+	err := uc.clusterData.Save(ctx, cluster)
+	if err != nil || !lost {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+// repairControlPlane replaces lost masters while etcd still has quorum: the etcd member and node object are removed,
+// cloud clusters release the instance and create a new master, bare metal machines are reset and join again
+func (uc *ClusterUsecase) repairControlPlane(ctx context.Context, cluster *Cluster) error {
+	lostNodes := cluster.GetLostMasterNodes()
+	masterNumber := len(cluster.GetMasterNodes())
+	if masterNumber-len(lostNodes) < masterNumber/2+1 {
+		return errors.WithMessagef(ErrClusterEventAborted, "etcd lost quorum, %d of %d control plane nodes are not ready", len(lostNodes), masterNumber)
+	}
+	for _, node := range lostNodes {
+		err := uc.recordStep(ctx, cluster, fmt.Sprintf("remove_master:%s", node.Name), func() error {
+			err := uc.clusterInfrastructure.RemoveEtcdMember(ctx, cluster, node)
+			if err != nil {
+				return err
+			}
+			return uc.clusterRuntime.DeleteNode(ctx, node)
+		})
 		if err != nil {
 			return err
 		}
-		cluster.SetZoneByLevel(zoneResources)
-		err = uc.clusterInfrastructure.ManageCloudBasicResource(ctx, cluster)
+		node.ClearError()
+		node.SetStatus(NodeStatus_NODE_DELETING)
+		if !cluster.Provider.IsCloud() {
+			continue
+		}
+		cluster.AddNode(&Node{
+			Name:         fmt.Sprintf("%s-%s", cluster.Name, uuid.NewString()),
+			Role:         NodeRole_MASTER,
+			Status:       NodeStatus_NODE_CREATING,
+			ClusterId:    cluster.Id,
+			NodeGroupId:  node.NodeGroupId,
+			Username:     node.Username,
+			ImageId:      node.ImageId,
+			InstanceType: node.InstanceType,
+			Labels:       node.Labels,
+		})
+	}
+	if cluster.Provider.IsCloud() {
+		// releases the lost instances, creates their replacements and moves the load balancer backends
+		err := uc.recordStep(ctx, cluster, ClusterStepManageNodeResource, func() error {
+			return uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+		})
+		if err != nil {
+			return err
+		}
+		cluster.SetNodeStatusFromTo(NodeStatus_NODE_DELETING, NodeStatus_NODE_DELETED)
+		cluster.SetNodeStatusFromTo(NodeStatus_NODE_CREATING, NodeStatus_NODE_PENDING)
+	} else {
+		// reset the bare metal machines first, then join them back as control plane nodes
+		err := uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+			return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+		})
 		if err != nil {
 			return err
 		}
+		for _, node := range lostNodes {
+			// a machine that could not be reset stays in error until RetryNode
+			if node.Status == NodeStatus_NODE_ERROR {
+				continue
+			}
+			node.SetStatus(NodeStatus_NODE_PENDING)
+		}
 	}
-	err := uc.clusterInfrastructure.GetNodesSystemInfo(ctx, cluster)
+	err := uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+		return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	})
 	if err != nil {
 		return err
 	}
-	if len(cluster.Nodes) == 0 {
-		return nil
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_PENDING, NodeStatus_NODE_RUNNING)
+	return uc.clusterRuntime.ReloadCluster(ctx, cluster)
+}
+
+// removeNodes takes the deleting nodes out of a running cluster: drain them,
+// reset kubernetes on the machines and release the cloud instances
+func (uc *ClusterUsecase) removeNodes(ctx context.Context, cluster *Cluster) error {
+	err := uc.drainDeletingNodes(ctx, cluster)
+	if err != nil {
+		return err
 	}
-	cluster.SetNodeStatusFromTo(NodeStatus_NODE_FINDING, NodeStatus_NODE_CREATING)
-	if uc.conf.Server.Env == Env_local {
-		err = uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+	err = uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+		return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	if cluster.Provider.IsCloud() {
+		err = uc.recordStep(ctx, cluster, ClusterStepManageNodeResource, func() error {
+			return uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+		})
 		if err != nil {
 			return err
 		}
-		return uc.clusterInfrastructure.WaitClusterSlbReady(ctx, cluster)
 	}
-	cluster.SetNodeStatusFromTo(NodeStatus_NODE_CREATING, NodeStatus_NODE_PENDING)
-	err = uc.clusterInfrastructure.Install(ctx, cluster)
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_DELETING, NodeStatus_NODE_DELETED)
+	return uc.clusterRuntime.ReloadCluster(ctx, cluster)
+}
+
+// drainDeletingNodes cordons and drains every deleting node and removes its node object,
+// pods protected by a PodDisruptionBudget are retried until NodeDrainTimeout
+func (uc *ClusterUsecase) drainDeletingNodes(ctx context.Context, cluster *Cluster) error {
+	for _, node := range cluster.Nodes {
+		if node.Status != NodeStatus_NODE_DELETING {
+			continue
+		}
+		err := uc.recordStep(ctx, cluster, fmt.Sprintf("drain_node:%s", node.Name), func() error {
+			err := uc.clusterRuntime.DrainNode(ctx, node, NodeDrainTimeout)
+			if err != nil {
+				return err
+			}
+			return uc.clusterRuntime.DeleteNode(ctx, node)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ClusterUsecase) HandlerClusterNotInstalled(ctx context.Context, cluster *Cluster) error {
+	if cluster.Status != ClusterStatus_STARTING {
+		return nil
+	}
+	err := uc.runProvisionSteps(ctx, cluster)
 	if err != nil {
 		return err
 	}
-	cluster.SetNodeStatusFromTo(NodeStatus_NODE_PENDING, NodeStatus_NODE_RUNNING)
-	err = uc.clusterRuntime.Install(ctx, cluster)
+	if len(cluster.Nodes) == 0 || uc.conf.Server.Env == Env_local {
+		return nil
+	}
+	cluster.SetStatus(ClusterStatus_RUNNING)
+	return nil
+}
+
+type clusterProvisionStep struct {
+	step ClusterStep
+	when func(*Cluster) bool
+	run  func(context.Context, *Cluster) error
+}
+
+// provisionSteps is the provisioning graph of a starting cluster, steps whose condition
+// does not hold are passed over without a checkpoint
+func (uc *ClusterUsecase) provisionSteps() []clusterProvisionStep {
+	isCloud := func(c *Cluster) bool { return c.Provider.IsCloud() }
+	isLocal := func(c *Cluster) bool { return len(c.Nodes) != 0 && uc.conf.Server.Env == Env_local }
+	isInstall := func(c *Cluster) bool { return len(c.Nodes) != 0 && uc.conf.Server.Env != Env_local }
+	return []clusterProvisionStep{
+		{step: ClusterStep_NETWORK, when: isCloud, run: func(ctx context.Context, c *Cluster) error {
+			c.SettingClusterLevelByNodeNumber()
+			zoneResources, err := uc.clusterInfrastructure.GetZones(ctx, c)
+			if err != nil {
+				return err
+			}
+			c.SetZoneByLevel(zoneResources)
+			return uc.clusterInfrastructure.CreateNetwork(ctx, c)
+		}},
+		{step: ClusterStep_KEY_PAIR, when: isCloud, run: uc.clusterInfrastructure.ImportKeyPair},
+		{step: ClusterStep_SYSTEM_INFO, when: func(*Cluster) bool { return true }, run: func(ctx context.Context, c *Cluster) error {
+			err := uc.clusterInfrastructure.GetNodesSystemInfo(ctx, c)
+			if err != nil {
+				return err
+			}
+			c.SetNodeStatusFromTo(NodeStatus_NODE_FINDING, NodeStatus_NODE_CREATING)
+			return nil
+		}},
+		{step: ClusterStep_SECURITY_GROUP, when: isLocal, run: uc.clusterInfrastructure.ManageSecurityGroup},
+		{step: ClusterStep_INSTANCES, when: isLocal, run: uc.clusterInfrastructure.ManageInstance},
+		{step: ClusterStep_SLB, when: isLocal, run: func(ctx context.Context, c *Cluster) error {
+			err := uc.clusterInfrastructure.ManageSLB(ctx, c)
+			if err != nil {
+				return err
+			}
+			return uc.clusterInfrastructure.WaitClusterSlbReady(ctx, c)
+		}},
+		{step: ClusterStep_PREFLIGHT, when: func(c *Cluster) bool { return isInstall(c) && c.Provider != ClusterProvider_Kind }, run: uc.preflight},
+		{step: ClusterStep_KUBEADM_INIT, when: isInstall, run: func(ctx context.Context, c *Cluster) error {
+			c.SetNodeStatusFromTo(NodeStatus_NODE_CREATING, NodeStatus_NODE_PENDING)
+			err := uc.clusterInfrastructure.InitControlPlane(ctx, c)
+			if err != nil {
+				return err
+			}
+			c.KubeConfig, err = uc.clusterInfrastructure.GetKubeConfig(ctx, c)
+			return err
+		}},
+		{step: ClusterStep_JOINS, when: isInstall, run: func(ctx context.Context, c *Cluster) error {
+			err := uc.clusterInfrastructure.JoinNodes(ctx, c)
+			if err != nil {
+				return err
+			}
+			c.SetNodeStatusFromTo(NodeStatus_NODE_PENDING, NodeStatus_NODE_RUNNING)
+			return nil
+		}},
+		{step: ClusterStep_RUNTIME_INSTALL, when: isInstall, run: uc.clusterRuntime.Install},
+	}
+}
+
+func (uc *ClusterUsecase) runProvisionSteps(ctx context.Context, cluster *Cluster) error {
+	checkpoints, err := uc.clusterData.GetCheckpoints(ctx, cluster.Id)
 	if err != nil {
 		return err
 	}
-	cluster.SetStatus(ClusterStatus_RUNNING)
+	checkpointMap := make(map[ClusterStep]*ClusterCheckpoint)
+	for _, checkpoint := range checkpoints {
+		checkpointMap[checkpoint.Step] = checkpoint
+	}
+	for _, provisionStep := range uc.provisionSteps() {
+		checkpoint, ok := checkpointMap[provisionStep.step]
+		if ok && checkpoint.IsDone() {
+			continue
+		}
+		if !provisionStep.when(cluster) {
+			continue
+		}
+		if !ok {
+			checkpoint = &ClusterCheckpoint{ClusterId: cluster.Id, Step: provisionStep.step}
+		}
+		checkpoint.Attempts++
+		err = uc.saveCheckpoint(ctx, checkpoint, ClusterStepStatus_RUNNING, "")
+		if err != nil {
+			return err
+		}
+		err = uc.recordStep(ctx, cluster, provisionStep.step.String(), func() error {
+			return provisionStep.run(ctx, cluster)
+		})
+		if err != nil {
+			if saveErr := uc.saveCheckpoint(ctx, checkpoint, ClusterStepStatus_FAILED, err.Error()); saveErr != nil {
+				uc.log.Errorf("failed to save cluster %d checkpoint %s: %v", cluster.Id, provisionStep.step, saveErr)
+			}
+			return errors.Wrapf(err, "provision step %s failed", provisionStep.step)
+		}
+		// persist what the step created before marking it done
+		err = uc.clusterData.Save(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		err = uc.saveCheckpoint(ctx, checkpoint, ClusterStepStatus_SUCCESS, "")
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (uc *ClusterUsecase) saveCheckpoint(ctx context.Context, checkpoint *ClusterCheckpoint, status ClusterStepStatus, errMsg string) error {
+	checkpoint.Status = status
+	checkpoint.Error = errMsg
+	checkpoint.UpdatedAt = time.Now().Format(time.DateTime)
+	return uc.clusterData.SaveCheckpoint(ctx, checkpoint)
+}
+
+// GetProvisionSteps returns every step of the provisioning graph with its checkpoint,
+// steps that never ran are reported as pending
+func (uc *ClusterUsecase) GetProvisionSteps(ctx context.Context, clusterId int64) ([]*ClusterCheckpoint, error) {
+	checkpoints, err := uc.clusterData.GetCheckpoints(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	checkpointMap := make(map[ClusterStep]*ClusterCheckpoint)
+	for _, checkpoint := range checkpoints {
+		checkpointMap[checkpoint.Step] = checkpoint
+	}
+	res := make([]*ClusterCheckpoint, 0)
+	for _, provisionStep := range uc.provisionSteps() {
+		checkpoint, ok := checkpointMap[provisionStep.step]
+		if !ok {
+			checkpoint = &ClusterCheckpoint{ClusterId: clusterId, Step: provisionStep.step, Status: ClusterStepStatus_PENDING}
+		}
+		res = append(res, checkpoint)
+	}
+	return res, nil
+}
+
+func (uc *ClusterUsecase) RetryProvisionStep(ctx context.Context, clusterId int64, step ClusterStep) error {
+	return uc.resumeProvisionStep(ctx, clusterId, step, ClusterStepStatus_PENDING)
+}
+
+func (uc *ClusterUsecase) SkipProvisionStep(ctx context.Context, clusterId int64, step ClusterStep) error {
+	return uc.resumeProvisionStep(ctx, clusterId, step, ClusterStepStatus_SKIPPED)
+}
+
+func (uc *ClusterUsecase) resumeProvisionStep(ctx context.Context, clusterId int64, step ClusterStep, status ClusterStepStatus) error {
+	cluster, err := uc.Get(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	if cluster.IsEmpty() {
+		return errors.New("cluster not found")
+	}
+	checkpoints, err := uc.clusterData.GetCheckpoints(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	var checkpoint *ClusterCheckpoint
+	for _, v := range checkpoints {
+		if v.Step == step {
+			checkpoint = v
+			break
+		}
+	}
+	if checkpoint == nil || checkpoint.Status != ClusterStepStatus_FAILED {
+		return errors.Errorf("provision step %s has not failed", step)
+	}
+	err = uc.saveCheckpoint(ctx, checkpoint, status, checkpoint.Error)
+	if err != nil {
+		return err
+	}
+	cluster.SetStatus(ClusterStatus_STARTING)
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+func (uc *ClusterUsecase) manageCloudBasicResource(ctx context.Context, cluster *Cluster) error {
+	var zoneResources []*CloudResource
+	err := uc.recordStep(ctx, cluster, ClusterStepGetZones, func() (err error) {
+		zoneResources, err = uc.clusterInfrastructure.GetZones(ctx, cluster)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	cluster.SetZoneByLevel(zoneResources)
+	return uc.recordStep(ctx, cluster, ClusterStepManageCloudBasicResource, func() error {
+		return uc.clusterInfrastructure.ManageCloudBasicResource(ctx, cluster)
+	})
+}
+
+// recordStep runs f and records it on the cluster timeline,
+// failing to write the timeline never fails the step itself
+func (uc *ClusterUsecase) recordStep(ctx context.Context, cluster *Cluster, name string, f func() error) error {
+	startedAt := time.Now()
+	event := &Event{
+		Name:      name,
+		Type:      EventType_STEP,
+		Source:    EventSource_CLUSTER,
+		SourceId:  cluster.Id,
+		Status:    EventStatus_PROCESSING,
+		StartedAt: startedAt.Format(time.DateTime),
+		CreatedAt: startedAt.Format(time.DateTime),
+		UpdatedAt: startedAt.Format(time.DateTime),
+	}
+	if parent := GetEvent(ctx); parent != nil {
+		event.ParentId = parent.Id
+		event.Action = parent.Action
+	}
+	if saveErr := uc.clusterData.SaveEvent(ctx, event); saveErr != nil {
+		uc.log.Errorf("failed to record cluster %d step %s: %v", cluster.Id, name, saveErr)
+	}
+	if step := getStepProgress(ctx); step != nil {
+		previous := step.swapReporter(uc.stepProgressReporter(ctx, event))
+		defer step.swapReporter(previous)
+	}
+	err := f()
+	finishedAt := time.Now()
+	event.Status = EventStatus_SUCCESS
+	if err != nil {
+		event.Status = EventStatus_FAILED
+		event.Error = err.Error()
+	}
+	event.FinishedAt = finishedAt.Format(time.DateTime)
+	event.UpdatedAt = event.FinishedAt
+	event.Duration = finishedAt.Sub(startedAt).Milliseconds()
+	if saveErr := uc.clusterData.SaveEvent(ctx, event); saveErr != nil {
+		uc.log.Errorf("failed to record cluster %d step %s: %v", cluster.Id, name, saveErr)
+	}
+	return err
+}
+
+func (uc *ClusterUsecase) ListEvents(ctx context.Context, filter *EventFilter) ([]*Event, int64, error) {
+	if filter.SourceId == 0 {
+		return nil, 0, errors.New("cluster id is required")
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = 10
+	}
+	if filter.PageSize > 100 {
+		filter.PageSize = 100
+	}
+	return uc.clusterData.ListEvents(ctx, filter)
+}
+
 func (uc *ClusterUsecase) Handlerlogs(ctx context.Context, key LogType, msg string) error {
 	return uc.clusterData.CommitLogs(ctx, key, msg)
 }
@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	CertificateCheckInterval     = 24 * time.Hour
+	CertificateExpiryWarningDays = 30
+
+	EventNameCertificateExpiring = "certificate_expiring"
+)
+
+// Certificate is a control plane certificate of a master, the kubeconfig client certificates are named after their file, eg: admin.conf
+type Certificate struct {
+	Id        int64  `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId int64  `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;index"`
+	NodeId    int64  `json:"node_id,omitempty" gorm:"column:node_id;default:0;NOT NULL"`
+	NodeName  string `json:"node_name,omitempty" gorm:"column:node_name;default:'';NOT NULL"`
+	Name      string `json:"name,omitempty" gorm:"column:name;default:'';NOT NULL"`
+	Issuer    string `json:"issuer,omitempty" gorm:"column:issuer;default:'';NOT NULL"`
+	ExpiresAt string `json:"expires_at,omitempty" gorm:"column:expires_at;default:'';NOT NULL"`
+	CheckedAt string `json:"checked_at,omitempty" gorm:"column:checked_at;default:'';NOT NULL"`
+}
+
+// DaysRemaining is negative once the certificate expired
+func (c *Certificate) DaysRemaining(now time.Time) (int, error) {
+	expiresAt, err := time.ParseInLocation(time.DateTime, c.ExpiresAt, time.Local)
+	if err != nil {
+		return 0, errors.Wrapf(err, "certificate %s of node %s has an invalid expiry", c.Name, c.NodeName)
+	}
+	return int(math.Floor(expiresAt.Sub(now).Hours() / 24)), nil
+}
+
+func (c *Cluster) IsCertificateCheckDue(now time.Time) bool {
+	if c.CertsCheckedAt == "" {
+		return true
+	}
+	checkedAt, err := time.ParseInLocation(time.DateTime, c.CertsCheckedAt, time.Local)
+	if err != nil {
+		return true
+	}
+	return now.Sub(checkedAt) >= CertificateCheckInterval
+}
+
+func (c *Cluster) HasRotatingCertsNode() bool {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_ROTATING_CERTS {
+			return true
+		}
+	}
+	return false
+}
+
+// ListCertificates returns the certificates the last check collected, refresh collects them from the masters first
+func (uc *ClusterUsecase) ListCertificates(ctx context.Context, clusterId int64, refresh bool) ([]*Certificate, error) {
+	cluster, err := uc.getCertificateCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if refresh {
+		return uc.collectCertificates(ctx, cluster)
+	}
+	return uc.clusterData.ListCertificates(ctx, cluster.Id)
+}
+
+// RotateCertificates queues renewing the certificates of every master, the masters are renewed one at a time
+// and their static pods restarted, it needs every master running
+func (uc *ClusterUsecase) RotateCertificates(ctx context.Context, clusterId int64) error {
+	cluster, err := uc.getCertificateCluster(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return errors.New("certificates can only be rotated on running clusters")
+	}
+	masters := cluster.GetMasterNodes()
+	if len(masters) == 0 {
+		return errors.New("the cluster has no master")
+	}
+	for _, node := range masters {
+		if node.Status != NodeStatus_NODE_RUNNING {
+			return errors.Errorf("master %s is %s, every master must be running to rotate certificates", node.Name, node.Status.String())
+		}
+	}
+	for _, node := range masters {
+		node.SetStatus(NodeStatus_NODE_ROTATING_CERTS)
+	}
+	return uc.applyNodeOperation(ctx, cluster)
+}
+
+// rotateCertificates renews the masters one at a time, a failing master is parked in error and the masters
+// after it are left untouched, the kubeconfig is read again once any master was renewed
+func (uc *ClusterUsecase) rotateCertificates(ctx context.Context, cluster *Cluster) error {
+	rotated, failed := false, false
+	for _, node := range cluster.GetMasterNodes() {
+		if node.Status != NodeStatus_NODE_ROTATING_CERTS {
+			continue
+		}
+		if failed {
+			node.SetStatus(NodeStatus_NODE_RUNNING)
+			continue
+		}
+		err := uc.recordStep(ctx, cluster, fmt.Sprintf("%s:%s", node.Status.String(), node.Name), func() error {
+			err := uc.clusterInfrastructure.RotateNodeCertificates(ctx, cluster, node)
+			if err != nil {
+				return err
+			}
+			return uc.clusterRuntime.WaitNodeReady(ctx, node, "", NodeReadyTimeout)
+		})
+		if err != nil {
+			uc.log.Errorf("rotate certificates of node %s failed: %v", node.Name, err)
+			node.SetError(NodeErrorType_INFRASTRUCTURE_ERROR, err)
+			failed = true
+			continue
+		}
+		node.ClearError()
+		node.SetStatus(NodeStatus_NODE_RUNNING)
+		rotated = true
+	}
+	if !rotated {
+		return nil
+	}
+	kubeConfig, err := uc.clusterInfrastructure.GetKubeConfig(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	cluster.KubeConfig = kubeConfig
+	uc.clusterRuntime.InvalidateClusterClient(cluster.Id)
+	if _, err = uc.collectCertificates(ctx, cluster); err != nil {
+		uc.log.Errorf("collect certificates of cluster %s failed: %v", cluster.Name, err)
+	}
+	return nil
+}
+
+func (uc *ClusterUsecase) runScheduledCertificateCheck(ctx context.Context, cluster *Cluster) error {
+	if cluster.Provider == ClusterProvider_Kind || !cluster.IsCertificateCheckDue(time.Now()) {
+		return nil
+	}
+	_, err := uc.collectCertificates(ctx, cluster)
+	return err
+}
+
+// collectCertificates reads the certificates of the running masters and replaces the stored ones, a master that
+// is not running or can not be reached keeps its stored certificates, nothing is saved when no master was read
+func (uc *ClusterUsecase) collectCertificates(ctx context.Context, cluster *Cluster) ([]*Certificate, error) {
+	storedCertificates, err := uc.clusterData.ListCertificates(ctx, cluster.Id)
+	if err != nil {
+		return nil, err
+	}
+	certificates := make([]*Certificate, 0)
+	checkedAt := time.Now().Format(time.DateTime)
+	collected := false
+	for _, node := range cluster.GetMasterNodes() {
+		if node.Status == NodeStatus_NODE_RUNNING {
+			nodeCertificates, err := uc.clusterInfrastructure.GetNodeCertificates(ctx, cluster, node)
+			if err == nil {
+				for _, certificate := range nodeCertificates {
+					certificate.ClusterId = cluster.Id
+					certificate.NodeId = node.Id
+					certificate.NodeName = node.Name
+					certificate.CheckedAt = checkedAt
+				}
+				certificates = append(certificates, nodeCertificates...)
+				collected = true
+				continue
+			}
+			uc.log.Warnf("collect certificates of node %s failed: %v", node.Name, err)
+		}
+		for _, certificate := range storedCertificates {
+			if certificate.NodeId == node.Id {
+				certificates = append(certificates, certificate)
+			}
+		}
+	}
+	if !collected {
+		return nil, errors.Errorf("no master of cluster %s returned its certificates", cluster.Name)
+	}
+	cluster.CertsCheckedAt = checkedAt
+	err = uc.clusterData.SaveCertificates(ctx, cluster, certificates)
+	if err != nil {
+		return nil, err
+	}
+	uc.warnExpiringCertificates(ctx, cluster, certificates)
+	return certificates, nil
+}
+
+// warnExpiringCertificates records a warning event on the cluster when certificates expire within CertificateExpiryWarningDays
+func (uc *ClusterUsecase) warnExpiringCertificates(ctx context.Context, cluster *Cluster, certificates []*Certificate) {
+	now := time.Now()
+	expiring := make([]*Certificate, 0)
+	daysRemaining := make(map[*Certificate]int)
+	for _, certificate := range certificates {
+		days, err := certificate.DaysRemaining(now)
+		if err != nil {
+			uc.log.Warnf("cluster %s %v", cluster.Name, err)
+			continue
+		}
+		if days < CertificateExpiryWarningDays {
+			expiring = append(expiring, certificate)
+			daysRemaining[certificate] = days
+		}
+	}
+	if len(expiring) == 0 {
+		return
+	}
+	slices.SortFunc(expiring, func(a, b *Certificate) int { return strings.Compare(a.ExpiresAt, b.ExpiresAt) })
+	names := make([]string, 0, len(expiring))
+	for _, certificate := range expiring {
+		names = append(names, fmt.Sprintf("%s/%s %dd", certificate.NodeName, certificate.Name, daysRemaining[certificate]))
+	}
+	message := fmt.Sprintf("%d certificates expire within %d days, rotate the certificates: %s",
+		len(expiring), CertificateExpiryWarningDays, strings.Join(names, ", "))
+	uc.log.Warnf("cluster %s %s", cluster.Name, message)
+	event := &Event{
+		Name:      EventNameCertificateExpiring,
+		Type:      EventType_WARNING,
+		Source:    EventSource_CLUSTER,
+		SourceId:  cluster.Id,
+		Data:      message,
+		CreatedAt: now.Format(time.DateTime),
+		UpdatedAt: now.Format(time.DateTime),
+	}
+	if err := uc.clusterData.SaveEvent(ctx, event); err != nil {
+		uc.log.Errorf("failed to record cluster %d certificate warning: %v", cluster.Id, err)
+	}
+}
+
+func (uc *ClusterUsecase) getCertificateCluster(ctx context.Context, clusterId int64) (*Cluster, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if cluster.Provider == ClusterProvider_Kind || cluster.ExternallyManaged {
+		return nil, errors.New("certificates are only managed on clusters provisioned by cloud-copilot")
+	}
+	return cluster, nil
+}
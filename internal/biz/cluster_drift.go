@@ -0,0 +1,274 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+)
+
+const CloudDriftCheckInterval = 15 * time.Minute
+
+type CloudDriftType int32
+
+const (
+	CloudDriftType_UNSPECIFIED CloudDriftType = 0
+	CloudDriftType_MISSING     CloudDriftType = 1
+	CloudDriftType_MODIFIED    CloudDriftType = 2
+	CloudDriftType_UNEXPECTED  CloudDriftType = 3
+)
+
+func (t CloudDriftType) String() string {
+	switch t {
+	case CloudDriftType_MISSING:
+		return "missing"
+	case CloudDriftType_MODIFIED:
+		return "modified"
+	case CloudDriftType_UNEXPECTED:
+		return "unexpected"
+	default:
+		return "unspecified"
+	}
+}
+
+type CloudDriftReportStatus int32
+
+const (
+	CloudDriftReportStatus_UNSPECIFIED CloudDriftReportStatus = 0
+	CloudDriftReportStatus_IN_SYNC     CloudDriftReportStatus = 1
+	CloudDriftReportStatus_DRIFTED     CloudDriftReportStatus = 2
+	CloudDriftReportStatus_REPAIRING   CloudDriftReportStatus = 3
+	CloudDriftReportStatus_FAILED      CloudDriftReportStatus = 4
+)
+
+func (s CloudDriftReportStatus) String() string {
+	switch s {
+	case CloudDriftReportStatus_IN_SYNC:
+		return "in_sync"
+	case CloudDriftReportStatus_DRIFTED:
+		return "drifted"
+	case CloudDriftReportStatus_REPAIRING:
+		return "repairing"
+	case CloudDriftReportStatus_FAILED:
+		return "failed"
+	default:
+		return "unspecified"
+	}
+}
+
+// CloudDriftReport is the latest comparison of the stored cloud resources of a cluster with the live cloud state
+type CloudDriftReport struct {
+	Id         int64                  `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId  int64                  `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;uniqueIndex"`
+	Status     CloudDriftReportStatus `json:"status,omitempty" gorm:"column:status;default:0;NOT NULL"`
+	Error      string                 `json:"error,omitempty" gorm:"column:error;default:'';NOT NULL"`
+	CheckedAt  string                 `json:"checked_at,omitempty" gorm:"column:checked_at;default:'';NOT NULL"`
+	RepairedAt string                 `json:"repaired_at,omitempty" gorm:"column:repaired_at;default:'';NOT NULL"`
+	Drifts     []*CloudDrift          `json:"drifts,omitempty" gorm:"-"`
+}
+
+// CloudDrift is one drifted resource, ResourceId is the stored cloud resource or security rule and empty for unexpected resources
+type CloudDrift struct {
+	Id           int64          `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId    int64          `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;index"`
+	ResourceId   string         `json:"resource_id,omitempty" gorm:"column:resource_id;default:'';NOT NULL"`
+	ResourceType ResourceType   `json:"resource_type,omitempty" gorm:"column:resource_type;default:0;NOT NULL"`
+	RefId        string         `json:"ref_id,omitempty" gorm:"column:ref_id;default:'';NOT NULL"`
+	Name         string         `json:"name,omitempty" gorm:"column:name;default:'';NOT NULL"`
+	Type         CloudDriftType `json:"type,omitempty" gorm:"column:type;default:0;NOT NULL"`
+	Detail       string         `json:"detail,omitempty" gorm:"column:detail;default:'';NOT NULL"`
+}
+
+func (r *CloudDriftReport) IsDue(now time.Time) bool {
+	if r == nil || r.CheckedAt == "" {
+		return true
+	}
+	checkedAt, err := time.ParseInLocation(time.DateTime, r.CheckedAt, time.Local)
+	if err != nil {
+		return true
+	}
+	return now.Sub(checkedAt) >= CloudDriftCheckInterval
+}
+
+// SecurityRuleKey identifies a security rule by protocol, source cidr and port range
+func SecurityRuleKey(protocol, ipCidr string, startPort, endPort int32) string {
+	return strings.Join([]string{strings.ToUpper(protocol), ipCidr, fmt.Sprintf("%d/%d", startPort, endPort)}, "-")
+}
+
+func (s *Security) RuleKey() string {
+	return SecurityRuleKey(s.Protocol, s.IpCidr, s.StartPort, s.EndPort)
+}
+
+// CloudTags decodes the tags of a stored resource with the enum values typed the way they were written to the cloud
+func (c *Cluster) CloudTags(resource *CloudResource) map[ResourceTypeKeyValue]any {
+	tags := c.DecodeTags(resource.Tags)
+	// enum values come back from json as numbers but were written by name
+	if number, ok := tags[ResourceTypeKeyValue_ACCESS].(float64); ok {
+		tags[ResourceTypeKeyValue_ACCESS] = ResourceTypeKeyValue(int32(number))
+	}
+	return tags
+}
+
+// CloudTagValues is the tag set written to the cloud for a stored resource, keyed by tag name
+func (c *Cluster) CloudTagValues(resource *CloudResource) map[string]string {
+	tagValues := make(map[string]string)
+	for key, value := range c.CloudTags(resource) {
+		tagValues[key.String()] = cast.ToString(value)
+	}
+	return tagValues
+}
+
+// GetCloudDriftReport returns the stored report, or runs the reconciler first when refresh is set or none exists
+func (uc *ClusterUsecase) GetCloudDriftReport(ctx context.Context, clusterId int64, refresh bool) (*CloudDriftReport, error) {
+	cluster, err := uc.getCloudDriftCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	report, err := uc.clusterData.GetCloudDriftReport(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if report != nil && report.Status == CloudDriftReportStatus_REPAIRING {
+		return report, nil
+	}
+	if report == nil || refresh {
+		return uc.detectCloudDrift(ctx, cluster)
+	}
+	return report, nil
+}
+
+// RepairCloudDrift queues the repair of the drifted resources, missing resources are created again and
+// security rules are set back to the cluster rules, unexpected resources other than security rules are only reported
+func (uc *ClusterUsecase) RepairCloudDrift(ctx context.Context, clusterId int64) (*CloudDriftReport, error) {
+	cluster, err := uc.getCloudDriftCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return nil, errors.New("only running clusters can be repaired")
+	}
+	report, err := uc.clusterData.GetCloudDriftReport(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil || report.Status != CloudDriftReportStatus_DRIFTED {
+		return nil, errors.New("no drift to repair, refresh the report first")
+	}
+	report.Status = CloudDriftReportStatus_REPAIRING
+	err = uc.clusterData.SaveCloudDriftReport(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.clusterData.Apply(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (uc *ClusterUsecase) getCloudDriftCluster(ctx context.Context, clusterId int64) (*Cluster, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if !cluster.Provider.IsCloud() || cluster.ExternallyManaged {
+		return nil, errors.New("drift detection is only supported on cloud clusters provisioned by cloud-copilot")
+	}
+	return cluster, nil
+}
+
+// detectCloudDrift runs the provider reconciler and stores the result as the latest report
+func (uc *ClusterUsecase) detectCloudDrift(ctx context.Context, cluster *Cluster) (*CloudDriftReport, error) {
+	report, err := uc.clusterData.GetCloudDriftReport(ctx, cluster.Id)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		report = &CloudDriftReport{ClusterId: cluster.Id}
+	}
+	drifts, detectErr := uc.clusterInfrastructure.DetectCloudDrift(ctx, cluster)
+	report.Error = ""
+	report.Drifts = drifts
+	report.CheckedAt = time.Now().Format(time.DateTime)
+	switch {
+	case detectErr != nil:
+		report.Status = CloudDriftReportStatus_FAILED
+		report.Error = detectErr.Error()
+		report.Drifts = nil
+	case len(drifts) == 0:
+		report.Status = CloudDriftReportStatus_IN_SYNC
+	default:
+		report.Status = CloudDriftReportStatus_DRIFTED
+	}
+	for _, drift := range report.Drifts {
+		drift.ClusterId = cluster.Id
+	}
+	err = uc.clusterData.SaveCloudDriftReport(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// runScheduledCloudDriftCheck runs the reconciler of a running cloud cluster once the last report is old enough
+func (uc *ClusterUsecase) runScheduledCloudDriftCheck(ctx context.Context, cluster *Cluster) error {
+	if !cluster.Provider.IsCloud() {
+		return nil
+	}
+	report, err := uc.clusterData.GetCloudDriftReport(ctx, cluster.Id)
+	if err != nil {
+		return err
+	}
+	if report != nil && report.Status == CloudDriftReportStatus_REPAIRING {
+		return nil
+	}
+	if !report.IsDue(time.Now()) {
+		return nil
+	}
+	report, err = uc.detectCloudDrift(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if report.Status == CloudDriftReportStatus_DRIFTED {
+		uc.log.Warnf("cluster %s cloud resources drifted: %d resources", cluster.Name, len(report.Drifts))
+	}
+	return nil
+}
+
+// getRepairingCloudDrift returns the report of a running cloud cluster with a queued repair
+func (uc *ClusterUsecase) getRepairingCloudDrift(ctx context.Context, cluster *Cluster) (*CloudDriftReport, error) {
+	if cluster.Status != ClusterStatus_RUNNING || !cluster.Provider.IsCloud() {
+		return nil, nil
+	}
+	report, err := uc.clusterData.GetCloudDriftReport(ctx, cluster.Id)
+	if err != nil || report == nil || report.Status != CloudDriftReportStatus_REPAIRING {
+		return nil, err
+	}
+	return report, nil
+}
+
+// repairCloudDrift lets the provider repair the drifted resources, then the reconciler runs once more for the report
+func (uc *ClusterUsecase) repairCloudDrift(ctx context.Context, cluster *Cluster, report *CloudDriftReport) error {
+	err := uc.recordStep(ctx, cluster, ClusterStepRepairCloudDrift, func() error {
+		return uc.clusterInfrastructure.RepairCloudDrift(ctx, cluster, report.Drifts)
+	})
+	if err != nil {
+		report.Status = CloudDriftReportStatus_FAILED
+		report.Error = err.Error()
+		// the cluster keeps running, the failure is kept on the report
+		uc.log.Errorf("repair cloud drift of cluster %s failed: %v", cluster.Name, err)
+		return uc.clusterData.SaveCloudDriftReport(ctx, report)
+	}
+	report, err = uc.detectCloudDrift(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	report.RepairedAt = time.Now().Format(time.DateTime)
+	return uc.clusterData.SaveCloudDriftReport(ctx, report)
+}
@@ -0,0 +1,344 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	EtcdSnapshotMinInterval = 10 * time.Minute
+	EtcdRestoreReadyTimeout = 10 * time.Minute
+)
+
+type EtcdBackupStorage int32
+
+const (
+	EtcdBackupStorage_UNSPECIFIED EtcdBackupStorage = 0
+	EtcdBackupStorage_LOCAL       EtcdBackupStorage = 1
+	EtcdBackupStorage_S3          EtcdBackupStorage = 2
+)
+
+func (s EtcdBackupStorage) String() string {
+	switch s {
+	case EtcdBackupStorage_LOCAL:
+		return "local"
+	case EtcdBackupStorage_S3:
+		return "s3"
+	default:
+		return "unspecified"
+	}
+}
+
+func EtcdBackupStorageFromString(s string) EtcdBackupStorage {
+	switch s {
+	case "local":
+		return EtcdBackupStorage_LOCAL
+	case "s3":
+		return EtcdBackupStorage_S3
+	default:
+		return EtcdBackupStorage_UNSPECIFIED
+	}
+}
+
+type EtcdSnapshotStatus int32
+
+const (
+	EtcdSnapshotStatus_UNSPECIFIED EtcdSnapshotStatus = 0
+	EtcdSnapshotStatus_CREATING    EtcdSnapshotStatus = 1
+	EtcdSnapshotStatus_READY       EtcdSnapshotStatus = 2
+	EtcdSnapshotStatus_FAILED      EtcdSnapshotStatus = 3
+)
+
+func (s EtcdSnapshotStatus) String() string {
+	switch s {
+	case EtcdSnapshotStatus_CREATING:
+		return "creating"
+	case EtcdSnapshotStatus_READY:
+		return "ready"
+	case EtcdSnapshotStatus_FAILED:
+		return "failed"
+	default:
+		return "unspecified"
+	}
+}
+
+// EtcdBackupPolicy is the snapshot schedule of a cluster, Schedule is the interval between snapshots such as "6h"
+type EtcdBackupPolicy struct {
+	Id          int64             `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId   int64             `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;uniqueIndex"`
+	Enabled     bool              `json:"enabled,omitempty" gorm:"column:enabled;default:false;NOT NULL"`
+	Schedule    string            `json:"schedule,omitempty" gorm:"column:schedule;default:'';NOT NULL"`
+	Retention   int32             `json:"retention,omitempty" gorm:"column:retention;default:0;NOT NULL"`
+	Storage     EtcdBackupStorage `json:"storage,omitempty" gorm:"column:storage;default:0;NOT NULL"`
+	S3Endpoint  string            `json:"s3_endpoint,omitempty" gorm:"column:s3_endpoint;default:'';NOT NULL"`
+	S3Region    string            `json:"s3_region,omitempty" gorm:"column:s3_region;default:'';NOT NULL"`
+	S3Bucket    string            `json:"s3_bucket,omitempty" gorm:"column:s3_bucket;default:'';NOT NULL"`
+	S3AccessKey string            `json:"s3_access_key,omitempty" gorm:"column:s3_access_key;default:'';NOT NULL"`
+	S3SecretKey string            `json:"s3_secret_key,omitempty" gorm:"column:s3_secret_key;default:'';NOT NULL"`
+	LastRunAt   int64             `json:"last_run_at,omitempty" gorm:"column:last_run_at;default:0;NOT NULL"` // unix seconds
+	UpdatedAt   string            `json:"updated_at,omitempty" gorm:"column:updated_at;default:'';NOT NULL"`
+}
+
+func (p *EtcdBackupPolicy) Interval() time.Duration {
+	interval, err := time.ParseDuration(p.Schedule)
+	if err != nil {
+		return 0
+	}
+	return interval
+}
+
+// IsDue reports whether the next scheduled snapshot should be taken now
+func (p *EtcdBackupPolicy) IsDue(now time.Time) bool {
+	if !p.Enabled || p.Interval() <= 0 {
+		return false
+	}
+	return now.Sub(time.Unix(p.LastRunAt, 0)) >= p.Interval()
+}
+
+func (p *EtcdBackupPolicy) Validate() error {
+	if p.ClusterId == 0 {
+		return errors.New("cluster id is required")
+	}
+	if p.Interval() < EtcdSnapshotMinInterval {
+		return errors.Errorf("schedule must be a duration of at least %s", EtcdSnapshotMinInterval)
+	}
+	if p.Retention <= 0 {
+		return errors.New("retention must be greater than 0")
+	}
+	switch p.Storage {
+	case EtcdBackupStorage_LOCAL:
+	case EtcdBackupStorage_S3:
+		if p.S3Bucket == "" || p.S3AccessKey == "" || p.S3SecretKey == "" {
+			return errors.New("s3 bucket, access key and secret key are required")
+		}
+	default:
+		return errors.New("storage must be local or s3")
+	}
+	return nil
+}
+
+// EtcdSnapshot is one etcd snapshot, Location is a server storage path or an s3://bucket/key url
+type EtcdSnapshot struct {
+	Id          int64              `json:"id,omitempty" gorm:"column:id;primaryKey;AUTO_INCREMENT"`
+	ClusterId   int64              `json:"cluster_id,omitempty" gorm:"column:cluster_id;default:0;NOT NULL;index"`
+	Name        string             `json:"name,omitempty" gorm:"column:name;default:'';NOT NULL"`
+	Storage     EtcdBackupStorage  `json:"storage,omitempty" gorm:"column:storage;default:0;NOT NULL"`
+	Location    string             `json:"location,omitempty" gorm:"column:location;default:'';NOT NULL"`
+	Size        int64              `json:"size,omitempty" gorm:"column:size;default:0;NOT NULL"`
+	Sha256      string             `json:"sha256,omitempty" gorm:"column:sha256;default:'';NOT NULL"`
+	Revision    int64              `json:"revision,omitempty" gorm:"column:revision;default:0;NOT NULL"`
+	TotalKeys   int64              `json:"total_keys,omitempty" gorm:"column:total_keys;default:0;NOT NULL"`
+	Status      EtcdSnapshotStatus `json:"status,omitempty" gorm:"column:status;default:0;NOT NULL"`
+	Error       string             `json:"error,omitempty" gorm:"column:error;default:'';NOT NULL"`
+	VerifiedAt  string             `json:"verified_at,omitempty" gorm:"column:verified_at;default:'';NOT NULL"`
+	VerifyError string             `json:"verify_error,omitempty" gorm:"column:verify_error;default:'';NOT NULL"`
+	CreatedAt   string             `json:"created_at,omitempty" gorm:"column:created_at;default:'';NOT NULL"`
+}
+
+func (uc *ClusterUsecase) GetEtcdBackupPolicy(ctx context.Context, clusterId int64) (*EtcdBackupPolicy, error) {
+	policy, err := uc.clusterData.GetEtcdBackupPolicy(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		policy = &EtcdBackupPolicy{ClusterId: clusterId, Storage: EtcdBackupStorage_LOCAL}
+	}
+	return policy, nil
+}
+
+// SaveEtcdBackupPolicy keeps the stored s3 secret when the update leaves it empty
+func (uc *ClusterUsecase) SaveEtcdBackupPolicy(ctx context.Context, policy *EtcdBackupPolicy) error {
+	cluster, err := uc.clusterData.Get(ctx, policy.ClusterId)
+	if err != nil {
+		return err
+	}
+	if cluster.IsEmpty() {
+		return errors.New("cluster not found")
+	}
+	if cluster.Distribution == ClusterDistribution_K3S {
+		return errors.New("k3s clusters take their own etcd snapshots")
+	}
+	if cluster.Provider == ClusterProvider_Kind || cluster.ExternallyManaged {
+		return errors.New("etcd snapshots are only supported on clusters provisioned by cloud-copilot")
+	}
+	current, err := uc.clusterData.GetEtcdBackupPolicy(ctx, policy.ClusterId)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		policy.Id = current.Id
+		policy.LastRunAt = current.LastRunAt
+		if policy.S3SecretKey == "" {
+			policy.S3SecretKey = current.S3SecretKey
+		}
+	}
+	err = policy.Validate()
+	if err != nil {
+		return err
+	}
+	policy.UpdatedAt = time.Now().Format(time.DateTime)
+	return uc.clusterData.SaveEtcdBackupPolicy(ctx, policy)
+}
+
+func (uc *ClusterUsecase) ListEtcdSnapshots(ctx context.Context, clusterId int64) ([]*EtcdSnapshot, error) {
+	return uc.clusterData.ListEtcdSnapshots(ctx, clusterId)
+}
+
+// VerifyEtcdSnapshot checks the stored checksum and lets etcdutl read the snapshot on a master
+func (uc *ClusterUsecase) VerifyEtcdSnapshot(ctx context.Context, clusterId, snapshotId int64) (*EtcdSnapshot, error) {
+	cluster, policy, snapshot, err := uc.getEtcdSnapshot(ctx, clusterId, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.VerifyError = ""
+	err = uc.clusterInfrastructure.VerifyEtcdSnapshot(ctx, cluster, policy, snapshot)
+	if err != nil {
+		snapshot.VerifyError = err.Error()
+	}
+	snapshot.VerifiedAt = time.Now().Format(time.DateTime)
+	err = uc.clusterData.SaveEtcdSnapshot(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// RestoreEtcdSnapshot queues the restore of the control plane from a snapshot
+func (uc *ClusterUsecase) RestoreEtcdSnapshot(ctx context.Context, clusterId, snapshotId int64) error {
+	cluster, _, _, err := uc.getEtcdSnapshot(ctx, clusterId, snapshotId)
+	if err != nil {
+		return err
+	}
+	if cluster.Status != ClusterStatus_RUNNING && cluster.Status != ClusterStatus_ERROR {
+		return errors.New("only running or failed clusters can be restored")
+	}
+	cluster.RestoreSnapshotId = snapshotId
+	cluster.SetStatus(ClusterStatus_RESTORING)
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+func (uc *ClusterUsecase) getEtcdSnapshot(ctx context.Context, clusterId, snapshotId int64) (*Cluster, *EtcdBackupPolicy, *EtcdSnapshot, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, nil, nil, errors.New("cluster not found")
+	}
+	snapshot, err := uc.clusterData.GetEtcdSnapshot(ctx, snapshotId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if snapshot == nil || snapshot.ClusterId != clusterId {
+		return nil, nil, nil, errors.New("snapshot not found")
+	}
+	if snapshot.Status != EtcdSnapshotStatus_READY {
+		return nil, nil, nil, errors.Errorf("snapshot is %s", snapshot.Status)
+	}
+	policy, err := uc.GetEtcdBackupPolicy(ctx, clusterId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return cluster, policy, snapshot, nil
+}
+
+// restoreEtcd stops every control plane node, restores the snapshot into each stacked etcd member and starts them again
+func (uc *ClusterUsecase) restoreEtcd(ctx context.Context, cluster *Cluster) error {
+	_, policy, snapshot, err := uc.getEtcdSnapshot(ctx, cluster.Id, cluster.RestoreSnapshotId)
+	if err != nil {
+		return errors.WithMessage(ErrClusterEventAborted, err.Error())
+	}
+	err = uc.recordStep(ctx, cluster, fmt.Sprintf("restore_etcd:%s", snapshot.Name), func() error {
+		return uc.clusterInfrastructure.RestoreEtcdSnapshot(ctx, cluster, policy, snapshot)
+	})
+	if err != nil {
+		return errors.WithMessagef(ErrClusterEventAborted, "restore snapshot %s failed: %v", snapshot.Name, err)
+	}
+	for _, node := range cluster.GetMasterNodes() {
+		err = uc.clusterRuntime.WaitNodeReady(ctx, node, "", EtcdRestoreReadyTimeout)
+		if err != nil {
+			return errors.WithMessage(ErrClusterEventAborted, err.Error())
+		}
+	}
+	cluster.RestoreSnapshotId = 0
+	cluster.SetStatus(ClusterStatus_RUNNING)
+	return nil
+}
+
+// runScheduledEtcdSnapshot takes the snapshot when the policy is due and prunes snapshots beyond the retention
+func (uc *ClusterUsecase) runScheduledEtcdSnapshot(ctx context.Context, cluster *Cluster) error {
+	policy, err := uc.clusterData.GetEtcdBackupPolicy(ctx, cluster.Id)
+	if err != nil || policy == nil {
+		return err
+	}
+	now := time.Now()
+	if !policy.IsDue(now) {
+		return nil
+	}
+	policy.LastRunAt = now.Unix()
+	err = uc.clusterData.SaveEtcdBackupPolicy(ctx, policy)
+	if err != nil {
+		return err
+	}
+	snapshot := &EtcdSnapshot{
+		ClusterId: cluster.Id,
+		Name:      fmt.Sprintf("%s-%s.db", cluster.Name, now.Format("20060102150405")),
+		Storage:   policy.Storage,
+		Status:    EtcdSnapshotStatus_CREATING,
+		CreatedAt: now.Format(time.DateTime),
+	}
+	err = uc.clusterData.SaveEtcdSnapshot(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	snapshotErr := uc.clusterInfrastructure.SaveEtcdSnapshot(ctx, cluster, policy, snapshot)
+	snapshot.Status = EtcdSnapshotStatus_READY
+	if snapshotErr != nil {
+		snapshot.Status = EtcdSnapshotStatus_FAILED
+		snapshot.Error = snapshotErr.Error()
+	}
+	err = uc.clusterData.SaveEtcdSnapshot(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	if snapshotErr != nil {
+		return snapshotErr
+	}
+	return uc.pruneEtcdSnapshots(ctx, policy)
+}
+
+func (uc *ClusterUsecase) pruneEtcdSnapshots(ctx context.Context, policy *EtcdBackupPolicy) error {
+	snapshots, err := uc.clusterData.ListEtcdSnapshots(ctx, policy.ClusterId)
+	if err != nil {
+		return err
+	}
+	// snapshots come newest first, failed attempts are kept as long as ready ones for their errors
+	kept := make(map[EtcdSnapshotStatus]int32)
+	for _, snapshot := range snapshots {
+		if snapshot.Status == EtcdSnapshotStatus_CREATING {
+			continue
+		}
+		if kept[snapshot.Status] < policy.Retention {
+			kept[snapshot.Status]++
+			continue
+		}
+		if snapshot.Status == EtcdSnapshotStatus_READY {
+			err = uc.clusterInfrastructure.DeleteEtcdSnapshot(ctx, policy, snapshot)
+			if err != nil {
+				return err
+			}
+		}
+		err = uc.clusterData.DeleteEtcdSnapshot(ctx, snapshot.Id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// CloudResourceManagedBy is the managed_by tag value of every cloud resource created by cloud-copilot
+const CloudResourceManagedBy = "cloud-copilot"
+
+const (
+	OrphanedReasonClusterNotFound = "cluster not found"
+	OrphanedReasonClusterDeleted  = "cluster deleted"
+
+	OrphanedErrorNotOrphaned = "no longer orphaned, skipped"
+)
+
+// OrphanedCloudResource is a tagged cloud resource whose cluster is gone, MonthlyCost is a rough list price estimate in USD
+type OrphanedCloudResource struct {
+	Region      string         `json:"region,omitempty"`
+	ClusterId   int64          `json:"cluster_id,omitempty"`
+	ClusterName string         `json:"cluster_name,omitempty"`
+	InstanceId  string         `json:"instance_id,omitempty"`
+	Resource    *CloudResource `json:"resource,omitempty"`
+	Reason      string         `json:"reason,omitempty"`
+	MonthlyCost float64        `json:"monthly_cost,omitempty"`
+	Deleted     bool           `json:"deleted,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// Instance identifies the database of a cloud-copilot installation, the one row is created on the first start
+// and its InstanceId tags the cloud resources the installation creates
+type Instance struct {
+	Id         int64  `json:"id,omitempty" gorm:"column:id;primaryKey"`
+	InstanceId string `json:"instance_id,omitempty" gorm:"column:instance_id;default:'';NOT NULL"`
+	CreatedAt  string `json:"created_at,omitempty" gorm:"column:created_at;default:'';NOT NULL"`
+}
+
+type CloudResourceGCArgs struct {
+	Provider  ClusterProvider
+	AccessId  string
+	AccessKey string
+	// Regions defaults to every region of the account
+	Regions []string
+	// Confirm deletes the reviewed ResourceIds that are still orphaned, without it the orphaned resources are only listed
+	Confirm     bool
+	ResourceIds []string
+}
+
+type CloudResourceGCResult struct {
+	Resources   []*OrphanedCloudResource `json:"resources,omitempty"`
+	MonthlyCost float64                  `json:"monthly_cost,omitempty"`
+}
+
+// CloudResourceGCUsecase finds the cloud resources left behind by clusters that no longer exist
+type CloudResourceGCUsecase struct {
+	clusterData           ClusterData
+	clusterInfrastructure ClusterInfrastructure
+	log                   *log.Helper
+}
+
+func NewCloudResourceGCUsecase(clusterData ClusterData, clusterInfrastructure ClusterInfrastructure, logger log.Logger) *CloudResourceGCUsecase {
+	return &CloudResourceGCUsecase{
+		clusterData:           clusterData,
+		clusterInfrastructure: clusterInfrastructure,
+		log:                   log.NewHelper(logger),
+	}
+}
+
+// CollectOrphanedCloudResources lists the tagged resources of this installation whose cluster is missing or deleted,
+// with confirm the reviewed resources are scanned again and the ones still orphaned are deleted per cluster and region
+// in the order DeleteNetwork uses
+func (uc *CloudResourceGCUsecase) CollectOrphanedCloudResources(ctx context.Context, args *CloudResourceGCArgs) (*CloudResourceGCResult, error) {
+	if !args.Provider.IsCloud() {
+		return nil, errors.New("only cloud providers are supported")
+	}
+	if args.AccessId == "" || args.AccessKey == "" {
+		return nil, errors.New("access id and access key are required")
+	}
+	if args.Confirm && len(args.ResourceIds) == 0 {
+		return nil, errors.New("the reviewed resource ids are required to confirm the deletion")
+	}
+	regions := args.Regions
+	if len(regions) == 0 {
+		regionResources, err := uc.clusterInfrastructure.GetRegions(ctx, args.Provider, args.AccessId, args.AccessKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, region := range regionResources {
+			regions = append(regions, region.RefId)
+		}
+	}
+	tagged := make([]*OrphanedCloudResource, 0)
+	for _, region := range regions {
+		resources, err := uc.clusterInfrastructure.ListTaggedCloudResources(ctx, args.Provider, args.AccessId, args.AccessKey, region)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list tagged cloud resources in region %s", region)
+		}
+		tagged = append(tagged, resources...)
+	}
+	orphans, err := uc.filterOrphans(ctx, tagged)
+	if err != nil {
+		return nil, err
+	}
+	if args.Confirm {
+		orphans = uc.filterReviewed(orphans, args.ResourceIds)
+	}
+	result := &CloudResourceGCResult{Resources: orphans}
+	for _, orphan := range orphans {
+		result.MonthlyCost += orphan.MonthlyCost
+	}
+	if !args.Confirm {
+		return result, nil
+	}
+	// DeleteNetwork works on the resources of one cluster in one region, a synthetic cluster carries them
+	groups := make(map[string][]*OrphanedCloudResource)
+	for _, orphan := range orphans {
+		if orphan.Error != "" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", orphan.Region, orphan.ClusterId)
+		groups[key] = append(groups[key], orphan)
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		group := groups[key]
+		cluster := &Cluster{
+			Id:             group[0].ClusterId,
+			Name:           group[0].ClusterName,
+			Provider:       args.Provider,
+			Region:         group[0].Region,
+			AccessId:       args.AccessId,
+			AccessKey:      args.AccessKey,
+			CloudResources: make([]*CloudResource, 0),
+		}
+		for _, orphan := range group {
+			cluster.CloudResources = append(cluster.CloudResources, orphan.Resource)
+		}
+		err = uc.clusterInfrastructure.DeleteOrphanedCloudResources(ctx, cluster)
+		if err != nil {
+			uc.log.Errorf("delete orphaned cloud resources of cluster %d in region %s failed: %v", cluster.Id, cluster.Region, err)
+		}
+		for _, orphan := range group {
+			if err != nil {
+				orphan.Error = err.Error()
+				continue
+			}
+			orphan.Deleted = true
+		}
+	}
+	return result, nil
+}
+
+// filterReviewed keeps the orphans the operator reviewed, reviewed resources that are gone or no longer orphaned
+// are reported as skipped
+func (uc *CloudResourceGCUsecase) filterReviewed(orphans []*OrphanedCloudResource, resourceIds []string) []*OrphanedCloudResource {
+	orphanMap := make(map[string]*OrphanedCloudResource)
+	for _, orphan := range orphans {
+		orphanMap[orphan.Resource.RefId] = orphan
+	}
+	reviewed := make([]*OrphanedCloudResource, 0, len(resourceIds))
+	for _, resourceId := range resourceIds {
+		if orphan, ok := orphanMap[resourceId]; ok {
+			reviewed = append(reviewed, orphan)
+			delete(orphanMap, resourceId)
+			continue
+		}
+		uc.log.Warnf("cloud resource %s is no longer orphaned, it is not deleted", resourceId)
+		reviewed = append(reviewed, &OrphanedCloudResource{
+			Resource: &CloudResource{RefId: resourceId},
+			Error:    OrphanedErrorNotOrphaned,
+		})
+	}
+	return reviewed
+}
+
+// filterOrphans keeps the resources of this installation whose cluster id is not stored or belongs to a deleted cluster,
+// the cluster ids of other installations sharing the account mean nothing in this database
+func (uc *CloudResourceGCUsecase) filterOrphans(ctx context.Context, resources []*OrphanedCloudResource) ([]*OrphanedCloudResource, error) {
+	instanceId := uc.clusterData.GetInstanceId()
+	clusterIds := make([]int64, 0)
+	for _, resource := range resources {
+		if resource.ClusterId != 0 {
+			clusterIds = append(clusterIds, resource.ClusterId)
+		}
+	}
+	clusters := make([]*Cluster, 0)
+	if len(clusterIds) != 0 {
+		var err error
+		clusters, err = uc.clusterData.GetClustersByIds(ctx, clusterIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	clusterMap := make(map[int64]*Cluster)
+	for _, cluster := range clusters {
+		clusterMap[cluster.Id] = cluster
+	}
+	orphans := make([]*OrphanedCloudResource, 0)
+	for _, resource := range resources {
+		// resources tagged before the cluster id or the instance id was written can not be matched and are left alone
+		if resource.ClusterId == 0 || instanceId == "" || resource.InstanceId != instanceId {
+			continue
+		}
+		cluster, ok := clusterMap[resource.ClusterId]
+		switch {
+		case !ok:
+			resource.Reason = OrphanedReasonClusterNotFound
+		case cluster.Status == ClusterStatus_DELETED:
+			resource.Reason = OrphanedReasonClusterDeleted
+		default:
+			continue
+		}
+		orphans = append(orphans, resource)
+	}
+	return orphans, nil
+}
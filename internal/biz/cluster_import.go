@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ImportCluster adopts a running cluster from its kubeconfig, the nodes and versions are discovered
+// right away so a bad kubeconfig fails the request, the operator is installed by the queued start
+func (uc *ClusterUsecase) ImportCluster(ctx context.Context, cluster *Cluster) error {
+	if cluster.Name == "" || cluster.KubeConfig == "" {
+		return errors.New("cluster name and kubeconfig are required")
+	}
+	existing, err := uc.clusterData.GetByName(ctx, cluster.Name)
+	if err != nil {
+		return err
+	}
+	if !existing.IsEmpty() {
+		return errors.New("cluster already exists")
+	}
+	err = uc.clusterRuntime.DiscoverCluster(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if len(cluster.Nodes) == 0 {
+		return errors.New("the cluster has no nodes")
+	}
+	cluster.ExternallyManaged = true
+	cluster.SetStatus(ClusterStatus_STARTING)
+	err = uc.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+// handleImportedCluster only installs the cloud-copilot operator, stopping an imported cluster
+// just stops managing it and never touches its machines or cloud resources
+func (uc *ClusterUsecase) handleImportedCluster(ctx context.Context, cluster *Cluster) error {
+	switch cluster.Status {
+	case ClusterStatus_STARTING:
+		err := uc.recordStep(ctx, cluster, ClusterStepRuntimeInstall, func() error {
+			return uc.clusterRuntime.Install(ctx, cluster)
+		})
+		if err != nil {
+			return err
+		}
+		cluster.SetStatus(ClusterStatus_RUNNING)
+	case ClusterStatus_STOPPING:
+		cluster.SetStatus(ClusterStatus_STOPPED)
+	case ClusterStatus_RUNNING:
+		if cluster.HasDeletingNode() {
+			return errors.WithMessage(ErrClusterEventAborted, "nodes of imported clusters are managed outside cloud-copilot")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,436 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+)
+
+const (
+	InventoryFormatCsv = "csv"
+	InventoryFormatIni = "ini"
+)
+
+// InventoryHost is a bare metal machine declared in the cluster inventory, an inventory replaces the node ip range
+type InventoryHost struct {
+	Id         string   `gorm:"column:id;primaryKey;NOT NULL" json:"id,omitempty"`
+	Host       string   `gorm:"column:host;default:'';NOT NULL" json:"host,omitempty"`
+	Port       int32    `gorm:"column:port;default:0;NOT NULL" json:"port,omitempty"`              // 22 when empty
+	Username   string   `gorm:"column:username;default:'';NOT NULL" json:"username,omitempty"`     // the cluster node username when empty
+	Credential string   `gorm:"column:credential;default:'';NOT NULL" json:"credential,omitempty"` // name of the node credential, the cluster private key when empty
+	Role       NodeRole `gorm:"column:role;default:0;NOT NULL" json:"role,omitempty"`              // the first host becomes the master when no master is declared
+	NodeGroup  string   `gorm:"column:node_group;default:'';NOT NULL" json:"node_group,omitempty"` // node group name, the hosts are grouped by hardware when empty
+	ClusterId  int64    `gorm:"column:cluster_id;default:0;NOT NULL" json:"cluster_id,omitempty"`
+}
+
+// NodeCredential is a private key or a password inventory hosts refer to by name, the secrets are write only
+type NodeCredential struct {
+	Id         string `gorm:"column:id;primaryKey;NOT NULL" json:"id,omitempty"`
+	Name       string `gorm:"column:name;default:'';NOT NULL" json:"name,omitempty"`
+	PrivateKey string `gorm:"column:private_key;default:'';NOT NULL" json:"private_key,omitempty"`
+	Password   string `gorm:"column:password;default:'';NOT NULL" json:"password,omitempty"`
+	ClusterId  int64  `gorm:"column:cluster_id;default:0;NOT NULL" json:"cluster_id,omitempty"`
+}
+
+type InventorySaveArgs struct {
+	ClusterId   int64
+	Format      string // csv or ini, the content replaces the hosts when set
+	Content     string
+	Hosts       []*InventoryHost
+	Credentials []*NodeCredential // upserted by name, empty secrets keep the stored ones
+}
+
+func (c *Cluster) GetInventoryHost(ip string) *InventoryHost {
+	for _, host := range c.InventoryHosts {
+		if host.Host == ip {
+			return host
+		}
+	}
+	return nil
+}
+
+func (c *Cluster) GetNodeCredential(name string) *NodeCredential {
+	for _, credential := range c.NodeCredentials {
+		if credential.Name == name {
+			return credential
+		}
+	}
+	return nil
+}
+
+// GetNodeSshCredential returns the port, private key and password the node is reached with, port 0 is the default one
+func (c *Cluster) GetNodeSshCredential(node *Node) (port int32, privateKey, password string) {
+	privateKey = c.PrivateKey
+	host := c.GetInventoryHost(node.Ip)
+	if host == nil {
+		return port, privateKey, ""
+	}
+	port = host.Port
+	if credential := c.GetNodeCredential(host.Credential); credential != nil {
+		privateKey, password = credential.PrivateKey, credential.Password
+	}
+	return port, privateKey, password
+}
+
+// ParseInventory reads the hosts of a csv file with a header row of host,port,user,credential,role,node_group,
+// or of an ansible ini inventory
+func ParseInventory(format, content string) ([]*InventoryHost, error) {
+	switch format {
+	case InventoryFormatCsv:
+		return parseCsvInventory(content)
+	case InventoryFormatIni:
+		return parseIniInventory(content)
+	default:
+		return nil, errors.Errorf("inventory format %q is invalid, csv or ini", format)
+	}
+}
+
+var csvInventoryColumns = []string{"host", "port", "user", "credential", "role", "node_group"}
+
+func parseCsvInventory(content string) ([]*InventoryHost, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "read inventory header")
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(csvInventoryColumns, column) {
+			return nil, errors.Errorf("inventory column %q is unknown, columns are %s", column, strings.Join(csvInventoryColumns, ","))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["host"]; !ok {
+		return nil, errors.New("inventory host column is required")
+	}
+	hosts := make([]*InventoryHost, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read inventory")
+		}
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		host, err := newInventoryHost(value("host"), map[string]string{
+			"port": value("port"), "user": value("user"), "credential": value("credential"), "node_group": value("node_group"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if role := value("role"); role != "" {
+			host.Role = NodeRoleFromString(strings.ToLower(role))
+			if host.Role == NodeRole_UNSPECIFIED {
+				return nil, errors.Errorf("inventory host %s role %q is invalid", host.Host, role)
+			}
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// ini groups the hosts take their role from, a host listed in several groups keeps the highest role
+var iniInventoryRoleGroups = map[string]NodeRole{
+	"master":             NodeRole_MASTER,
+	"masters":            NodeRole_MASTER,
+	"control_plane":      NodeRole_MASTER,
+	"kube_control_plane": NodeRole_MASTER,
+	"worker":             NodeRole_WORKER,
+	"workers":            NodeRole_WORKER,
+	"node":               NodeRole_WORKER,
+	"nodes":              NodeRole_WORKER,
+	"kube_node":          NodeRole_WORKER,
+	"edge":               NodeRole_EDGE,
+	"edges":              NodeRole_EDGE,
+}
+
+var iniHostRange = regexp.MustCompile(`\[(\d+):(\d+)\]`)
+
+// parseIniInventory reads an ansible ini inventory, hosts are merged by name across groups,
+// host vars override group vars which override all:vars and group children are ignored
+func parseIniInventory(content string) ([]*InventoryHost, error) {
+	type iniHost struct {
+		vars   map[string]string
+		groups []string
+	}
+	iniHosts := make(map[string]*iniHost)
+	hostNames := make([]string, 0)
+	groupVars := make(map[string]map[string]string)
+	section := "ungrouped"
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		if strings.HasSuffix(section, ":children") {
+			continue
+		}
+		if group, ok := strings.CutSuffix(section, ":vars"); ok {
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, errors.Errorf("inventory line %d: group var must be key=value", lineNumber)
+			}
+			if groupVars[group] == nil {
+				groupVars[group] = make(map[string]string)
+			}
+			groupVars[group][strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+			continue
+		}
+		fields := strings.Fields(line)
+		vars := make(map[string]string)
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, errors.Errorf("inventory line %d: host var %q must be key=value", lineNumber, field)
+			}
+			vars[key] = strings.Trim(value, `"'`)
+		}
+		names, err := expandIniHostRange(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "inventory line %d", lineNumber)
+		}
+		for _, name := range names {
+			host, ok := iniHosts[name]
+			if !ok {
+				host = &iniHost{vars: make(map[string]string)}
+				iniHosts[name] = host
+				hostNames = append(hostNames, name)
+			}
+			for key, value := range vars {
+				host.vars[key] = value
+			}
+			host.groups = append(host.groups, section)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read inventory")
+	}
+	hosts := make([]*InventoryHost, 0)
+	for _, name := range hostNames {
+		iniHost := iniHosts[name]
+		vars := make(map[string]string)
+		for _, group := range append([]string{"all"}, iniHost.groups...) {
+			for key, value := range groupVars[group] {
+				vars[key] = value
+			}
+		}
+		for key, value := range iniHost.vars {
+			vars[key] = value
+		}
+		address := name
+		if vars["ansible_host"] != "" {
+			address = vars["ansible_host"]
+		}
+		host, err := newInventoryHost(address, map[string]string{
+			"port":       firstNonEmpty(vars["ansible_port"], vars["ansible_ssh_port"]),
+			"user":       firstNonEmpty(vars["ansible_user"], vars["ansible_ssh_user"]),
+			"credential": vars["credential"],
+			"node_group": vars["node_group"],
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range iniHost.groups {
+			if role, ok := iniInventoryRoleGroups[group]; ok && (host.Role == NodeRole_UNSPECIFIED || role < host.Role) {
+				host.Role = role
+			}
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// expandIniHostRange expands a numeric ansible host range, eg: 10.0.0.[1:3]
+func expandIniHostRange(name string) ([]string, error) {
+	match := iniHostRange.FindStringSubmatchIndex(name)
+	if match == nil {
+		return []string{name}, nil
+	}
+	startText, endText := name[match[2]:match[3]], name[match[4]:match[5]]
+	start, end := cast.ToInt(strings.TrimLeft(startText, "0")), cast.ToInt(strings.TrimLeft(endText, "0"))
+	if start > end {
+		return nil, errors.Errorf("host range %s is invalid", name)
+	}
+	names := make([]string, 0)
+	for i := start; i <= end; i++ {
+		number := fmt.Sprint(i)
+		if len(startText) > 1 && strings.HasPrefix(startText, "0") {
+			number = fmt.Sprintf("%0*d", len(startText), i)
+		}
+		names = append(names, name[:match[0]]+number+name[match[1]:])
+	}
+	return names, nil
+}
+
+func newInventoryHost(address string, values map[string]string) (*InventoryHost, error) {
+	host := &InventoryHost{
+		Host:       address,
+		Username:   values["user"],
+		Credential: values["credential"],
+		NodeGroup:  values["node_group"],
+	}
+	if values["port"] != "" {
+		port, err := cast.ToInt32E(values["port"])
+		if err != nil {
+			return nil, errors.Errorf("inventory host %s port %q is invalid", address, values["port"])
+		}
+		host.Port = port
+	}
+	return host, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// validateInventory checks the hosts are unique ips and refer to credentials holding a private key or a password
+func (c *Cluster) validateInventory() error {
+	hosts := make(map[string]bool)
+	for _, host := range c.InventoryHosts {
+		if net.ParseIP(host.Host) == nil {
+			return errors.Errorf("inventory host %q must be an ip address", host.Host)
+		}
+		if hosts[host.Host] {
+			return errors.Errorf("inventory host %s is declared twice", host.Host)
+		}
+		hosts[host.Host] = true
+		if host.Port < 0 || host.Port > 65535 {
+			return errors.Errorf("inventory host %s port %d is invalid", host.Host, host.Port)
+		}
+		if host.Username == "" && c.NodeUsername == "" {
+			return errors.Errorf("inventory host %s needs a user, the cluster has no node username", host.Host)
+		}
+		if host.Credential != "" && c.GetNodeCredential(host.Credential) == nil {
+			return errors.Errorf("inventory host %s refers to the unknown credential %s", host.Host, host.Credential)
+		}
+	}
+	for _, credential := range c.NodeCredentials {
+		if credential.Name == "" {
+			return errors.New("node credential name is required")
+		}
+		if credential.PrivateKey == "" && credential.Password == "" {
+			return errors.Errorf("node credential %s needs a private key or a password", credential.Name)
+		}
+	}
+	return nil
+}
+
+// GetInventory returns the cluster with its inventory hosts and node credentials
+func (uc *ClusterUsecase) GetInventory(ctx context.Context, clusterId int64) (*Cluster, error) {
+	return uc.getInventoryCluster(ctx, clusterId)
+}
+
+// SaveInventory replaces the inventory hosts and upserts the node credentials, credentials no host refers to are dropped,
+// a running cluster joins the added hosts and removes the dropped workers through Apply
+func (uc *ClusterUsecase) SaveInventory(ctx context.Context, args *InventorySaveArgs) (*Cluster, error) {
+	cluster, err := uc.getInventoryCluster(ctx, args.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	hosts := args.Hosts
+	if args.Content != "" {
+		hosts, err = ParseInventory(args.Format, args.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, credential := range args.Credentials {
+		current := cluster.GetNodeCredential(credential.Name)
+		if current == nil {
+			credential.Id = uuid.NewString()
+			credential.ClusterId = cluster.Id
+			cluster.NodeCredentials = append(cluster.NodeCredentials, credential)
+			continue
+		}
+		if credential.PrivateKey != "" || credential.Password != "" {
+			current.PrivateKey, current.Password = credential.PrivateKey, credential.Password
+		}
+	}
+	for _, host := range hosts {
+		if current := cluster.GetInventoryHost(host.Host); current != nil {
+			host.Id = current.Id
+		} else {
+			host.Id = uuid.NewString()
+		}
+		host.ClusterId = cluster.Id
+	}
+	credentials := make([]*NodeCredential, 0)
+	for _, credential := range cluster.NodeCredentials {
+		if slices.ContainsFunc(hosts, func(host *InventoryHost) bool { return host.Credential == credential.Name }) {
+			credentials = append(credentials, credential)
+		}
+	}
+	cluster.InventoryHosts, cluster.NodeCredentials = hosts, credentials
+	err = cluster.validateInventory()
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status == ClusterStatus_RUNNING {
+		for _, node := range cluster.GetMasterNodes() {
+			if cluster.GetInventoryHost(node.Ip) == nil {
+				return nil, errors.Errorf("master %s can not be removed from the inventory of a running cluster", node.Ip)
+			}
+		}
+	}
+	err = uc.clusterData.SaveInventory(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return cluster, nil
+	}
+	cluster.SetBareMetalNode()
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return cluster, uc.clusterData.Apply(ctx, cluster)
+}
+
+func (uc *ClusterUsecase) getInventoryCluster(ctx context.Context, clusterId int64) (*Cluster, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if cluster.Provider != ClusterProvider_BareMetal || cluster.ExternallyManaged {
+		return nil, errors.New("an inventory is only kept for bare metal clusters provisioned by cloud-copilot")
+	}
+	switch cluster.Status {
+	case ClusterStatus_UNSPECIFIED, ClusterStatus_CREATING, ClusterStatus_RUNNING, ClusterStatus_STOPPED:
+		return cluster, nil
+	default:
+		return nil, errors.Errorf("the inventory can not be changed while the cluster is %s", cluster.Status.String())
+	}
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const KindNodeGroupLabel = "cloud-copilot.io/node-group"
+
+// SetKindNodes lays out one kind node per target size of each node group, the first node is the control plane.
+// kind names its nodes <cluster>-control-plane, <cluster>-worker, <cluster>-worker2... in the order of its config,
+// the layout is fixed once the kind cluster exists
+func (c *Cluster) SetKindNodes() {
+	if len(c.Nodes) != 0 {
+		return
+	}
+	if len(c.NodeGroups) == 0 {
+		c.AddNodeGroup(&NodeGroup{
+			Id:         uuid.NewString(),
+			Name:       c.Name,
+			ClusterId:  c.Id,
+			Type:       NodeGroupType_NORMAL,
+			MinSize:    1,
+			MaxSize:    10,
+			TargetSize: 2,
+		})
+	}
+	workerNumber := 0
+	for _, nodeGroup := range c.NodeGroups {
+		labels, _ := json.Marshal(map[string]string{KindNodeGroupLabel: nodeGroup.Name})
+		for range make([]struct{}, max(nodeGroup.TargetSize, 1)) {
+			node := &Node{
+				Role:        NodeRole_WORKER,
+				Status:      NodeStatus_NODE_CREATING,
+				ClusterId:   c.Id,
+				NodeGroupId: nodeGroup.Id,
+				Labels:      string(labels),
+			}
+			if len(c.Nodes) == 0 {
+				node.Role = NodeRole_MASTER
+				node.Name = fmt.Sprintf("%s-control-plane", c.Name)
+			} else {
+				workerNumber++
+				node.Name = fmt.Sprintf("%s-worker", c.Name)
+				if workerNumber > 1 {
+					node.Name = fmt.Sprintf("%s%d", node.Name, workerNumber)
+				}
+			}
+			c.AddNode(node)
+		}
+	}
+}
+
+// handleKindCluster creates, stops and starts kind clusters on the cloud-copilot host
+func (uc *ClusterUsecase) handleKindCluster(ctx context.Context, cluster *Cluster) error {
+	switch cluster.Status {
+	case ClusterStatus_STARTING:
+		cluster.SetKindNodes()
+		err := uc.recordStep(ctx, cluster, ClusterStepInstall, func() error {
+			err := uc.clusterInfrastructure.CreateKindCluster(ctx, cluster)
+			if err != nil {
+				return err
+			}
+			cluster.KubeConfig, err = uc.clusterInfrastructure.GetKubeConfig(ctx, cluster)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		cluster.SetNodeStatus(NodeStatus_NODE_RUNNING)
+		err = uc.recordStep(ctx, cluster, ClusterStepRuntimeInstall, func() error {
+			return uc.clusterRuntime.Install(ctx, cluster)
+		})
+		if err != nil {
+			return err
+		}
+		cluster.SetStatus(ClusterStatus_RUNNING)
+	case ClusterStatus_STOPPING:
+		err := uc.recordStep(ctx, cluster, ClusterStepUnInstall, func() error {
+			return uc.clusterInfrastructure.StopKindCluster(ctx, cluster)
+		})
+		if err != nil {
+			return err
+		}
+		// the stopped node containers keep their state until the cluster starts again or is deleted
+		cluster.SetNodeStatus(NodeStatus_NODE_PENDING)
+		cluster.SetStatus(ClusterStatus_STOPPED)
+	case ClusterStatus_RUNNING:
+		if cluster.HasDeletingNode() {
+			return errors.WithMessage(ErrClusterEventAborted, "kind clusters cannot remove nodes, recreate the cluster instead")
+		}
+	}
+	return nil
+}
+
+// LoadKindImage copies an image from the docker daemon of the cloud-copilot host into every node of a kind cluster
+func (uc *ClusterUsecase) LoadKindImage(ctx context.Context, clusterId int64, image string) error {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	if cluster.IsEmpty() {
+		return errors.New("cluster not found")
+	}
+	if cluster.Provider != ClusterProvider_Kind {
+		return errors.New("images can only be loaded into kind clusters")
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return errors.New("cluster is not running")
+	}
+	return uc.clusterInfrastructure.LoadKindImage(ctx, cluster, image)
+}
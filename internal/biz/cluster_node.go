@@ -0,0 +1,313 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// NodeSystemInfo is the hardware systeminfo.sh reports on the node, memory and disk sizes are in GiB
+type NodeSystemInfo struct {
+	Os      string       `json:"os,omitempty"`
+	Arch    NodeArchType `json:"arch,omitempty"`
+	Cpu     int32        `json:"cpu,omitempty"`
+	Memory  int32        `json:"memory,omitempty"`
+	Gpu     int32        `json:"gpu,omitempty"`
+	GpuInfo string       `json:"gpu_info,omitempty"`
+	Disk    int32        `json:"disk,omitempty"`
+	Disks   []*Disk      `json:"disks,omitempty"`
+}
+
+type NodeFilter struct {
+	ClusterId   int64
+	NodeGroupId string
+	Status      NodeStatus
+	Role        NodeRole
+}
+
+func (c *Cluster) GetNodeById(nodeId int64) *Node {
+	for _, node := range c.Nodes {
+		if node.Id == nodeId {
+			return node
+		}
+	}
+	return nil
+}
+
+// HasOperatingNode reports whether a reboot or re-init is queued on a node
+func (c *Cluster) HasOperatingNode() bool {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_REBOOTING || node.Status == NodeStatus_NODE_REINITIALIZING {
+			return true
+		}
+	}
+	return false
+}
+
+// GetJumpHostKey returns the pinned host key of the jump host at addr
+func (c *Cluster) GetJumpHostKey(addr string) string {
+	for _, line := range strings.Split(c.JumpHostKeys, "\n") {
+		if hostAddr, hostKey, ok := strings.Cut(line, " "); ok && hostAddr == addr {
+			return hostKey
+		}
+	}
+	return ""
+}
+
+func (c *Cluster) SetJumpHostKey(addr, hostKey string) {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(c.JumpHostKeys, "\n") {
+		if line != "" && !strings.HasPrefix(line, addr+" ") {
+			lines = append(lines, line)
+		}
+	}
+	c.JumpHostKeys = strings.Join(append(lines, addr+" "+hostKey), "\n")
+}
+
+func (uc *ClusterUsecase) ListNodes(ctx context.Context, filter *NodeFilter) ([]*Node, error) {
+	cluster, err := uc.clusterData.Get(ctx, filter.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	nodes := make([]*Node, 0)
+	for _, node := range cluster.Nodes {
+		if filter.NodeGroupId != "" && node.NodeGroupId != filter.NodeGroupId {
+			continue
+		}
+		if filter.Status != NodeStatus_UNSPECIFIED && node.Status != filter.Status {
+			continue
+		}
+		if filter.Role != NodeRole_UNSPECIFIED && node.Role != filter.Role {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// GetNodeSystemInfo runs systeminfo.sh on the node and returns the parsed hardware
+func (uc *ClusterUsecase) GetNodeSystemInfo(ctx context.Context, clusterId, nodeId int64) (*NodeSystemInfo, error) {
+	cluster, node, err := uc.getOperableNode(ctx, clusterId, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	return uc.clusterInfrastructure.GetNodeSystemInfo(ctx, cluster, node)
+}
+
+// RebootNode queues a drain, reboot and uncordon of the node
+func (uc *ClusterUsecase) RebootNode(ctx context.Context, clusterId, nodeId int64) error {
+	return uc.queueNodeOperation(ctx, clusterId, nodeId, NodeStatus_NODE_REBOOTING)
+}
+
+// ReinitNode queues running nodeinit.sh and the component install on the node again
+func (uc *ClusterUsecase) ReinitNode(ctx context.Context, clusterId, nodeId int64) error {
+	return uc.queueNodeOperation(ctx, clusterId, nodeId, NodeStatus_NODE_REINITIALIZING)
+}
+
+// ReplaceNode provisions a new instance in the node group of a cloud worker, joins it and removes the old one,
+// a master goes through the control plane repair which replaces the instance on cloud and rejoins the machine on bare metal
+func (uc *ClusterUsecase) ReplaceNode(ctx context.Context, clusterId, nodeId int64) error {
+	cluster, node, err := uc.getOperableNode(ctx, clusterId, nodeId)
+	if err != nil {
+		return err
+	}
+	if node.Role == NodeRole_MASTER {
+		if !cluster.IsHighAvailability() {
+			return errors.New("masters of a cluster without high availability can not be replaced, the control plane repair needs other masters to keep etcd quorum")
+		}
+		masterNumber := len(cluster.GetMasterNodes())
+		lostNumber := len(cluster.GetLostMasterNodes())
+		if !slices.Contains(cluster.GetLostMasterNodes(), node) {
+			lostNumber++
+		}
+		if masterNumber-lostNumber < masterNumber/2+1 {
+			return errors.Errorf("replacing master %s would lose etcd quorum, %d of %d masters would be left", node.Name, masterNumber-lostNumber, masterNumber)
+		}
+	}
+	node.ClearError()
+	if node.Role == NodeRole_MASTER {
+		// parked as a lost running master so the control plane repair picks it up
+		node.SetStatus(NodeStatus_NODE_RUNNING)
+		node.SetError(NodeErrorType_CLUSTER_ERROR, errors.New("replacement requested"))
+		return uc.applyNodeOperation(ctx, cluster)
+	}
+	if !cluster.Provider.IsCloud() {
+		return errors.New("bare metal workers can not be replaced, re-init the node or add a new machine instead")
+	}
+	if cluster.GetNodeGroup(node.NodeGroupId) == nil {
+		return errors.New("node group of the node not found")
+	}
+	node.SetStatus(NodeStatus_NODE_REPLACING)
+	cluster.AddNode(&Node{
+		Name:        fmt.Sprintf("%s-%s", cluster.Name, uuid.NewString()),
+		Role:        node.Role,
+		Status:      NodeStatus_NODE_FINDING,
+		ClusterId:   cluster.Id,
+		NodeGroupId: node.NodeGroupId,
+		Labels:      node.Labels,
+	})
+	return uc.applyNodeOperation(ctx, cluster)
+}
+
+// AcceptNodeHostKey pins the host key the node presents now after a rebuild rotated it,
+// the fingerprint verified out of band must match the presented key
+func (uc *ClusterUsecase) AcceptNodeHostKey(ctx context.Context, clusterId, nodeId int64, fingerprint string) (*Node, error) {
+	if fingerprint == "" {
+		return nil, errors.New("host key fingerprint is required")
+	}
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if cluster.Provider == ClusterProvider_Kind || cluster.ExternallyManaged {
+		return nil, errors.New("host keys are only pinned on clusters provisioned by cloud-copilot")
+	}
+	node := cluster.GetNodeById(nodeId)
+	if node == nil {
+		return nil, errors.New("node not found")
+	}
+	hostKey, err := uc.clusterInfrastructure.ScanNodeHostKey(ctx, cluster, node)
+	if err != nil {
+		return nil, err
+	}
+	if presented := utils.HostKeyFingerprint(hostKey); presented != fingerprint {
+		return nil, errors.Errorf("node %s presents host key %s, not %s", node.Name, presented, fingerprint)
+	}
+	node.HostKey = hostKey
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (uc *ClusterUsecase) queueNodeOperation(ctx context.Context, clusterId, nodeId int64, status NodeStatus) error {
+	cluster, node, err := uc.getOperableNode(ctx, clusterId, nodeId)
+	if err != nil {
+		return err
+	}
+	node.SetStatus(status)
+	return uc.applyNodeOperation(ctx, cluster)
+}
+
+func (uc *ClusterUsecase) applyNodeOperation(ctx context.Context, cluster *Cluster) error {
+	err := uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+// ResumeFailedNodes puts the nodes that failed in status back to it and clears their error
+func (c *Cluster) ResumeFailedNodes(status NodeStatus) {
+	for _, node := range c.Nodes {
+		if node.Status == NodeStatus_NODE_ERROR && node.ErrorStatus == status {
+			node.ClearError()
+			node.SetStatus(status)
+		}
+	}
+}
+
+// getOperableNode returns a running or failed node of a running cluster reachable over ssh
+func (uc *ClusterUsecase) getOperableNode(ctx context.Context, clusterId, nodeId int64) (*Cluster, *Node, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, nil, errors.New("cluster not found")
+	}
+	if cluster.Provider == ClusterProvider_Kind || cluster.ExternallyManaged {
+		return nil, nil, errors.New("nodes can only be operated on clusters provisioned by cloud-copilot")
+	}
+	if cluster.Status != ClusterStatus_RUNNING {
+		return nil, nil, errors.New("nodes can only be operated on running clusters")
+	}
+	node := cluster.GetNodeById(nodeId)
+	if node == nil {
+		return nil, nil, errors.New("node not found")
+	}
+	if node.Status != NodeStatus_NODE_RUNNING && node.Status != NodeStatus_NODE_ERROR {
+		return nil, nil, errors.Errorf("node %s is %s", node.Name, node.Status.String())
+	}
+	return cluster, node, nil
+}
+
+// operateNodes reboots or re-inits the queued nodes one at a time, each node is drained first
+// and uncordoned once it is ready again, a failing node is parked in error and the others carry on
+func (uc *ClusterUsecase) operateNodes(ctx context.Context, cluster *Cluster) error {
+	for _, node := range cluster.Nodes {
+		var operate func() error
+		switch node.Status {
+		case NodeStatus_NODE_REBOOTING:
+			operate = func() error { return uc.clusterInfrastructure.RebootNode(ctx, cluster, node) }
+		case NodeStatus_NODE_REINITIALIZING:
+			operate = func() error { return uc.clusterInfrastructure.ReinitNode(ctx, cluster, node) }
+		default:
+			continue
+		}
+		errorType := NodeErrorType_CLUSTER_ERROR
+		err := uc.recordStep(ctx, cluster, fmt.Sprintf("%s:%s", node.Status.String(), node.Name), func() error {
+			err := uc.clusterRuntime.DrainNode(ctx, node, NodeDrainTimeout)
+			if err != nil {
+				return err
+			}
+			err = operate()
+			if err != nil {
+				errorType = NodeErrorType_INFRASTRUCTURE_ERROR
+				return err
+			}
+			err = uc.clusterRuntime.WaitNodeReady(ctx, node, "", NodeReadyTimeout)
+			if err != nil {
+				return err
+			}
+			return uc.clusterRuntime.UncordonNode(ctx, node)
+		})
+		if err != nil {
+			uc.log.Errorf("%s node %s failed: %v", node.Status.String(), node.Name, err)
+			node.SetError(errorType, err)
+			continue
+		}
+		node.ClearError()
+		node.SetStatus(NodeStatus_NODE_RUNNING)
+	}
+	return nil
+}
+
+// joinPendingNodes joins the nodes put back to pending by RetryNode
+func (uc *ClusterUsecase) joinPendingNodes(ctx context.Context, cluster *Cluster) error {
+	err := uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+		return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_PENDING, NodeStatus_NODE_RUNNING)
+	return uc.clusterRuntime.ReloadCluster(ctx, cluster)
+}
+
+// RetryNode puts a failed node back to the status it failed in, only that phase runs again,
+// a join that failed after the node was prepared only runs the join
+func (uc *ClusterUsecase) RetryNode(ctx context.Context, clusterId, nodeId int64) error {
+	cluster, node, err := uc.getOperableNode(ctx, clusterId, nodeId)
+	if err != nil {
+		return err
+	}
+	if node.Status != NodeStatus_NODE_ERROR || node.ErrorStatus == NodeStatus_UNSPECIFIED {
+		return errors.Errorf("node %s has no failed operation to retry", node.Name)
+	}
+	node.SetStatus(node.ErrorStatus)
+	node.ErrorStatus = NodeStatus_UNSPECIFIED
+	return uc.applyNodeOperation(ctx, cluster)
+}
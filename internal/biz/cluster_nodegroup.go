@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (ng *NodeGroup) Validate() error {
+	if ng.Name == "" {
+		return errors.New("node group name is required")
+	}
+	if ng.Type == NodeGroupType_UNSPECIFIED {
+		return errors.New("node group type is required")
+	}
+	if ng.Arch == NodeArchType_UNSPECIFIED {
+		return errors.New("node group arch is required")
+	}
+	if ng.Cpu <= 0 || ng.Memory <= 0 {
+		return errors.New("node group cpu and memory must be greater than 0")
+	}
+	if ng.Gpu < 0 {
+		return errors.New("node group gpu can not be negative")
+	}
+	if (ng.Gpu > 0) != (ng.GpuSpec != NodeGPUSpec_UNSPECIFIED) {
+		return errors.New("node group gpu and gpu spec must be set together")
+	}
+	if ng.Type == NodeGroupType_GPU_ACCELERATERD && ng.Gpu == 0 {
+		return errors.New("gpu accelerated node group requires gpu")
+	}
+	if ng.MinSize < 0 {
+		return errors.New("node group min size can not be negative")
+	}
+	if ng.MaxSize <= 0 || ng.MaxSize < ng.MinSize {
+		return errors.New("node group max size must be greater than 0 and not less than min size")
+	}
+	if ng.TargetSize < ng.MinSize || ng.TargetSize > ng.MaxSize {
+		return errors.Errorf("node group target size must be between %d and %d", ng.MinSize, ng.MaxSize)
+	}
+	return nil
+}
+
+// SyncNodeGroupSize adds finding workers until the node group has its target size and marks
+// the newest surplus workers deleting, masters are never removed by a resize
+func (c *Cluster) SyncNodeGroupSize(nodeGroup *NodeGroup) {
+	nodes := make([]*Node, 0)
+	for _, node := range c.Nodes {
+		if node.NodeGroupId == nodeGroup.Id && !node.DeleteNode() && node.Status != NodeStatus_NODE_REPLACING {
+			nodes = append(nodes, node)
+		}
+	}
+	for i := len(nodes); i < int(nodeGroup.TargetSize); i++ {
+		c.AddNode(&Node{
+			Name:        fmt.Sprintf("%s-%s", c.Name, uuid.NewString()),
+			Role:        NodeRole_WORKER,
+			Status:      NodeStatus_NODE_FINDING,
+			ClusterId:   c.Id,
+			NodeGroupId: nodeGroup.Id,
+		})
+	}
+	surplus := len(nodes) - int(nodeGroup.TargetSize)
+	for i := len(nodes) - 1; i >= 0 && surplus > 0; i-- {
+		if nodes[i].Role == NodeRole_MASTER {
+			continue
+		}
+		nodes[i].SetStatus(NodeStatus_NODE_DELETING)
+		surplus--
+	}
+}
+
+func (c *Cluster) getNodeGroupMasterCount(nodeGroupId string) int32 {
+	var count int32
+	for _, node := range c.GetMasterNodes() {
+		if node.NodeGroupId == nodeGroupId {
+			count++
+		}
+	}
+	return count
+}
+
+func (uc *ClusterUsecase) ListNodeGroups(ctx context.Context, clusterId int64) ([]*NodeGroup, error) {
+	cluster, err := uc.getNodeGroupCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.NodeGroups, nil
+}
+
+// SaveNodeGroup creates the node group or updates the one with the same id, on a running cluster
+// the nodes are provisioned or removed to match the target size through Apply
+func (uc *ClusterUsecase) SaveNodeGroup(ctx context.Context, clusterId int64, nodeGroup *NodeGroup) (*NodeGroup, error) {
+	cluster, err := uc.getNodeGroupCluster(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	current, err := uc.mergeNodeGroup(ctx, cluster, nodeGroup)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.applyNodeGroups(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// DeleteNodeGroup removes the node group, its nodes are drained and released by the cluster event,
+// the node group holding masters can not be deleted
+func (uc *ClusterUsecase) DeleteNodeGroup(ctx context.Context, clusterId int64, nodeGroupId string) error {
+	cluster, err := uc.getNodeGroupCluster(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	nodeGroup := cluster.GetNodeGroup(nodeGroupId)
+	if nodeGroup == nil {
+		return errors.New("node group not found")
+	}
+	err = cluster.removeNodeGroup(nodeGroup)
+	if err != nil {
+		return err
+	}
+	return uc.applyNodeGroups(ctx, cluster)
+}
+
+// mergeNodeGroup validates the node group and adds it to the cluster or updates the one with the same id, nothing is saved
+func (uc *ClusterUsecase) mergeNodeGroup(ctx context.Context, cluster *Cluster, nodeGroup *NodeGroup) (*NodeGroup, error) {
+	err := nodeGroup.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if sameName := cluster.GetNodeGroupByName(nodeGroup.Name); sameName != nil && sameName.Id != nodeGroup.Id {
+		return nil, errors.Errorf("node group %s already exists", nodeGroup.Name)
+	}
+	current := nodeGroup
+	if nodeGroup.Id != "" {
+		current = cluster.GetNodeGroup(nodeGroup.Id)
+		if current == nil {
+			return nil, errors.New("node group not found")
+		}
+		if current.UniqueKey() != nodeGroup.UniqueKey() && len(cluster.GetNodeByNodeGroupId(current.Id)) != 0 {
+			return nil, errors.New("the instance spec of a node group with running nodes can not be changed, create a new node group instead")
+		}
+		if masterCount := cluster.getNodeGroupMasterCount(current.Id); nodeGroup.TargetSize < masterCount {
+			return nil, errors.Errorf("node group %s has %d masters, target size can not be less", current.Name, masterCount)
+		}
+	}
+	err = uc.clusterInfrastructure.ValidateNodeGroup(ctx, cluster, nodeGroup)
+	if err != nil {
+		return nil, err
+	}
+	if nodeGroup.Id == "" {
+		nodeGroup.Id = uuid.NewString()
+		nodeGroup.ClusterId = cluster.Id
+		cluster.AddNodeGroup(nodeGroup)
+		return nodeGroup, nil
+	}
+	current.Name = nodeGroup.Name
+	current.Type = nodeGroup.Type
+	current.Os = nodeGroup.Os
+	current.Arch = nodeGroup.Arch
+	current.Cpu = nodeGroup.Cpu
+	current.Memory = nodeGroup.Memory
+	current.Gpu = nodeGroup.Gpu
+	current.GpuSpec = nodeGroup.GpuSpec
+	current.MinSize = nodeGroup.MinSize
+	current.MaxSize = nodeGroup.MaxSize
+	current.TargetSize = nodeGroup.TargetSize
+	return current, nil
+}
+
+// removeNodeGroup drops the node group and marks its nodes deleting, nothing is saved
+func (c *Cluster) removeNodeGroup(nodeGroup *NodeGroup) error {
+	if c.getNodeGroupMasterCount(nodeGroup.Id) != 0 {
+		return errors.Errorf("node group %s holds masters and can not be deleted", nodeGroup.Name)
+	}
+	nodeGroup.SetTargetSize(0)
+	c.SyncNodeGroupSize(nodeGroup)
+	nodeGroups := make([]*NodeGroup, 0)
+	for _, v := range c.NodeGroups {
+		if v.Id != nodeGroup.Id {
+			nodeGroups = append(nodeGroups, v)
+		}
+	}
+	c.NodeGroups = nodeGroups
+	return nil
+}
+
+// applyNodeGroups saves the node groups, a running cluster also resizes its nodes through the cluster event
+func (uc *ClusterUsecase) applyNodeGroups(ctx context.Context, cluster *Cluster) error {
+	if cluster.Status != ClusterStatus_RUNNING {
+		return uc.clusterData.Save(ctx, cluster)
+	}
+	for _, nodeGroup := range cluster.NodeGroups {
+		cluster.SyncNodeGroupSize(nodeGroup)
+	}
+	err := uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return uc.clusterData.Apply(ctx, cluster)
+}
+
+// addNodes provisions the nodes a node group resize or a replacement added to a running cluster and joins them,
+// the replaced nodes are removed once their replacements joined
+func (uc *ClusterUsecase) addNodes(ctx context.Context, cluster *Cluster) error {
+	err := uc.recordStep(ctx, cluster, ClusterStepGetNodesSystemInfo, func() error {
+		return uc.clusterInfrastructure.GetNodesSystemInfo(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_FINDING, NodeStatus_NODE_CREATING)
+	err = uc.recordStep(ctx, cluster, ClusterStepManageNodeResource, func() error {
+		return uc.clusterInfrastructure.ManageNodeResource(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_CREATING, NodeStatus_NODE_PENDING)
+	err = uc.recordStep(ctx, cluster, ClusterStepHandlerNodes, func() error {
+		return uc.clusterInfrastructure.HandlerNodes(ctx, cluster)
+	})
+	if err != nil {
+		return err
+	}
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_PENDING, NodeStatus_NODE_RUNNING)
+	cluster.SetNodeStatusFromTo(NodeStatus_NODE_REPLACING, NodeStatus_NODE_DELETING)
+	if cluster.HasDeletingNode() {
+		return uc.removeNodes(ctx, cluster)
+	}
+	return uc.clusterRuntime.ReloadCluster(ctx, cluster)
+}
+
+func (uc *ClusterUsecase) getNodeGroupCluster(ctx context.Context, clusterId int64) (*Cluster, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if !cluster.Provider.IsCloud() || cluster.ExternallyManaged {
+		return nil, errors.New("node groups can only be managed on cloud clusters provisioned by cloud-copilot")
+	}
+	switch cluster.Status {
+	case ClusterStatus_UNSPECIFIED, ClusterStatus_CREATING, ClusterStatus_RUNNING, ClusterStatus_STOPPED:
+		return cluster, nil
+	default:
+		return nil, errors.Errorf("node groups can not be changed while the cluster is %s", cluster.Status.String())
+	}
+}
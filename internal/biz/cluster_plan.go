@@ -0,0 +1,243 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+)
+
+// plan resource kinds beside ResourceType
+const (
+	PlanResourceCluster  = "cluster"
+	PlanResourceNode     = "node"
+	PlanResourceSecurity = "security"
+)
+
+type ClusterPlanChange struct {
+	ResourceType string      `json:"resource_type,omitempty"`
+	Action       EventAction `json:"action,omitempty"`
+	Id           string      `json:"id,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Fields       []string    `json:"fields,omitempty"`
+}
+
+type ClusterPlan struct {
+	ClusterId int64                `json:"cluster_id,omitempty"`
+	Status    ClusterStatus        `json:"status,omitempty"`
+	Changes   []*ClusterPlanChange `json:"changes,omitempty"`
+}
+
+func (p *ClusterPlan) add(resourceType, id, name string, action EventAction, fields ...string) {
+	p.Changes = append(p.Changes, &ClusterPlanChange{
+		ResourceType: resourceType,
+		Action:       action,
+		Id:           id,
+		Name:         name,
+		Fields:       fields,
+	})
+}
+
+// Count returns how many changes of the action the plan holds
+func (p *ClusterPlan) Count(action EventAction) int32 {
+	var count int32
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// ResourceTypes returns the resource types of the plan in order of first appearance
+func (p *ClusterPlan) ResourceTypes() []string {
+	resourceTypes := make([]string, 0)
+	for _, change := range p.Changes {
+		if !slices.Contains(resourceTypes, change.ResourceType) {
+			resourceTypes = append(resourceTypes, change.ResourceType)
+		}
+	}
+	return resourceTypes
+}
+
+// Plan previews what starting or stopping the cluster would change, it never calls the cloud provider
+func (uc *ClusterUsecase) Plan(ctx context.Context, clusterId int64, status ClusterStatus) (*ClusterPlan, error) {
+	if status != ClusterStatus_STARTING && status != ClusterStatus_STOPPING {
+		return nil, errors.New("plan status must be starting or stopping")
+	}
+	current, err := uc.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil || current.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if current.ExternallyManaged {
+		return nil, errors.New("imported clusters have no infrastructure to plan")
+	}
+	plan := &ClusterPlan{ClusterId: clusterId, Status: status, Changes: make([]*ClusterPlanChange, 0)}
+	if status == ClusterStatus_STOPPING {
+		planStop(plan, current)
+		return plan, nil
+	}
+	desired := &Cluster{}
+	clusterJson, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(clusterJson, desired)
+	if err != nil {
+		return nil, err
+	}
+	desired.prepareStart()
+	if desired.Provider.IsCloud() && desired.SettingClusterLevelByNodeNumber() {
+		// only zones already known are used, looking up new ones needs the cloud api
+		desired.SetZoneByLevel(current.GetCloudResource(ResourceType_AVAILABILITY_ZONES))
+	}
+	planStart(plan, current, desired)
+	return plan, nil
+}
+
+func planStart(plan *ClusterPlan, current, desired *Cluster) {
+	clusterFields := diffFields(map[string][2]any{
+		"vpc_cidr":     {current.VpcCidr, desired.VpcCidr},
+		"pod_cidr":     {current.PodCidr, desired.PodCidr},
+		"service_cidr": {current.ServiceCidr, desired.ServiceCidr},
+		"subnet_cidrs": {current.SubnetCidrs, desired.SubnetCidrs},
+		"domain":       {current.Domain, desired.Domain},
+		"level":        {current.Level, desired.Level},
+	})
+	if len(clusterFields) != 0 {
+		plan.add(PlanResourceCluster, cast.ToString(current.Id), current.Name, EventAction_UPDATE, clusterFields...)
+	}
+
+	currentResources := make(map[string]*CloudResource)
+	for _, resource := range current.CloudResources {
+		currentResources[resource.Id] = resource
+	}
+	desiredResources := make(map[string]bool)
+	for _, resource := range desired.CloudResources {
+		desiredResources[resource.Id] = true
+		currentResource, ok := currentResources[resource.Id]
+		if !ok {
+			plan.add(resource.Type.String(), resource.Id, resource.Name, EventAction_CREATE)
+			continue
+		}
+		fields := diffFields(map[string][2]any{
+			"name":   {currentResource.Name, resource.Name},
+			"ref_id": {currentResource.RefId, resource.RefId},
+			"value":  {currentResource.Value, resource.Value},
+			"tags":   {currentResource.Tags, resource.Tags},
+		})
+		if len(fields) != 0 {
+			plan.add(resource.Type.String(), resource.Id, resource.Name, EventAction_UPDATE, fields...)
+		}
+	}
+	for _, resource := range current.CloudResources {
+		if !desiredResources[resource.Id] {
+			plan.add(resource.Type.String(), resource.Id, resource.Name, EventAction_DELETE)
+		}
+	}
+
+	currentNodes := make(map[int64]*Node)
+	for _, node := range current.Nodes {
+		currentNodes[node.Id] = node
+	}
+	desiredNodes := make(map[int64]bool)
+	for _, node := range desired.Nodes {
+		if node.Id == 0 {
+			plan.add(PlanResourceNode, "", node.Name, EventAction_CREATE)
+			continue
+		}
+		desiredNodes[node.Id] = true
+		if node.Status == NodeStatus_NODE_DELETING {
+			plan.add(PlanResourceNode, cast.ToString(node.Id), node.Name, EventAction_DELETE)
+			continue
+		}
+		currentNode, ok := currentNodes[node.Id]
+		if !ok {
+			continue
+		}
+		fields := diffFields(map[string][2]any{
+			"name":          {currentNode.Name, node.Name},
+			"ip":            {currentNode.Ip, node.Ip},
+			"role":          {currentNode.Role, node.Role},
+			"status":        {currentNode.Status, node.Status},
+			"instance_type": {currentNode.InstanceType, node.InstanceType},
+			"node_group_id": {currentNode.NodeGroupId, node.NodeGroupId},
+		})
+		if len(fields) != 0 {
+			plan.add(PlanResourceNode, cast.ToString(node.Id), node.Name, EventAction_UPDATE, fields...)
+		}
+	}
+	for _, node := range current.Nodes {
+		if !desiredNodes[node.Id] {
+			plan.add(PlanResourceNode, cast.ToString(node.Id), node.Name, EventAction_DELETE)
+		}
+	}
+
+	// security rules only get their defaults on the first start, match them by name
+	currentSecuritys := make(map[string]*Security)
+	for _, security := range current.Securitys {
+		currentSecuritys[security.Name] = security
+	}
+	desiredSecuritys := make(map[string]bool)
+	for _, security := range desired.Securitys {
+		desiredSecuritys[security.Name] = true
+		currentSecurity, ok := currentSecuritys[security.Name]
+		if !ok {
+			plan.add(PlanResourceSecurity, security.Id, security.Name, EventAction_CREATE)
+			continue
+		}
+		fields := diffFields(map[string][2]any{
+			"start_port": {currentSecurity.StartPort, security.StartPort},
+			"end_port":   {currentSecurity.EndPort, security.EndPort},
+			"protocol":   {currentSecurity.Protocol, security.Protocol},
+			"ip_cidr":    {currentSecurity.IpCidr, security.IpCidr},
+			"access":     {currentSecurity.Access, security.Access},
+		})
+		if len(fields) != 0 {
+			plan.add(PlanResourceSecurity, currentSecurity.Id, security.Name, EventAction_UPDATE, fields...)
+		}
+	}
+	for _, security := range current.Securitys {
+		if !desiredSecuritys[security.Name] {
+			plan.add(PlanResourceSecurity, security.Id, security.Name, EventAction_DELETE)
+		}
+	}
+}
+
+func planStop(plan *ClusterPlan, current *Cluster) {
+	for _, node := range current.Nodes {
+		if node.Status == NodeStatus_UNSPECIFIED || node.Status == NodeStatus_NODE_DELETED {
+			continue
+		}
+		plan.add(PlanResourceNode, cast.ToString(node.Id), node.Name, EventAction_DELETE)
+	}
+	if !current.Provider.IsCloud() {
+		return
+	}
+	for _, security := range current.Securitys {
+		plan.add(PlanResourceSecurity, security.Id, security.Name, EventAction_DELETE)
+	}
+	for _, resource := range current.CloudResources {
+		// regions and zones are looked up, not owned by the cluster
+		if resource.Type == ResourceType_REGION || resource.Type == ResourceType_AVAILABILITY_ZONES {
+			continue
+		}
+		plan.add(resource.Type.String(), resource.Id, resource.Name, EventAction_DELETE)
+	}
+}
+
+func diffFields(values map[string][2]any) []string {
+	fields := make([]string, 0)
+	for field, value := range values {
+		if value[0] != value[1] {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
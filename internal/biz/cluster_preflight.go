@@ -0,0 +1,158 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type PreflightStatus int32
+
+const (
+	PreflightStatus_UNSPECIFIED PreflightStatus = 0
+	PreflightStatus_PASS        PreflightStatus = 1
+	PreflightStatus_WARN        PreflightStatus = 2
+	PreflightStatus_FAIL        PreflightStatus = 3
+)
+
+func (ps PreflightStatus) String() string {
+	switch ps {
+	case PreflightStatus_PASS:
+		return "pass"
+	case PreflightStatus_WARN:
+		return "warn"
+	case PreflightStatus_FAIL:
+		return "fail"
+	default:
+		return "unspecified"
+	}
+}
+
+func PreflightStatusFromString(s string) PreflightStatus {
+	switch s {
+	case "pass":
+		return PreflightStatus_PASS
+	case "warn":
+		return PreflightStatus_WARN
+	case "fail":
+		return PreflightStatus_FAIL
+	default:
+		return PreflightStatus_UNSPECIFIED
+	}
+}
+
+// PreflightCheck is the result of one check preflight.sh runs on a node, eg: os, kernel, swap, port_6443
+type PreflightCheck struct {
+	Name    string          `json:"name"`
+	Status  PreflightStatus `json:"status"`
+	Message string          `json:"message"`
+}
+
+func (n *Node) GetPreflightChecks() []*PreflightCheck {
+	checks := make([]*PreflightCheck, 0)
+	if n.Preflight == "" {
+		return checks
+	}
+	if err := json.Unmarshal([]byte(n.Preflight), &checks); err != nil {
+		return make([]*PreflightCheck, 0)
+	}
+	return checks
+}
+
+func (n *Node) SetPreflightChecks(checks []*PreflightCheck) {
+	data, err := json.Marshal(checks)
+	if err != nil {
+		return
+	}
+	n.Preflight = string(data)
+}
+
+// GetPreflightStatus returns the worst status of the last preflight, unspecified when the node was never checked
+func (n *Node) GetPreflightStatus() PreflightStatus {
+	status := PreflightStatus_UNSPECIFIED
+	for _, check := range n.GetPreflightChecks() {
+		if check.Status > status {
+			status = check.Status
+		}
+	}
+	return status
+}
+
+// checkPreflight fails when any node has a failed check, warnings never block the install
+func checkPreflight(nodes []*Node) error {
+	failures := make([]string, 0)
+	for _, node := range nodes {
+		for _, check := range node.GetPreflightChecks() {
+			if check.Status == PreflightStatus_FAIL {
+				failures = append(failures, fmt.Sprintf("%s %s: %s", node.Name, check.Name, check.Message))
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return errors.Errorf("preflight failed, %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// preflight checks the nodes about to be installed, any failed check blocks the install
+func (uc *ClusterUsecase) preflight(ctx context.Context, cluster *Cluster) error {
+	nodes := make([]*Node, 0)
+	for _, node := range cluster.Nodes {
+		if node.Status == NodeStatus_NODE_CREATING {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+	err := uc.clusterInfrastructure.PreflightNodes(ctx, cluster, nodes)
+	if err != nil {
+		return err
+	}
+	return checkPreflight(nodes)
+}
+
+// RunPreflight runs the preflight checks on the nodes of the cluster now and keeps the report on each node,
+// the declared hosts of a bare metal cluster not yet running are checked before they are installed
+func (uc *ClusterUsecase) RunPreflight(ctx context.Context, clusterId int64) ([]*Node, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if cluster.Provider == ClusterProvider_Kind || cluster.ExternallyManaged {
+		return nil, errors.New("preflight only runs on clusters provisioned by cloud-copilot")
+	}
+	switch cluster.Status {
+	case ClusterStatus_UNSPECIFIED, ClusterStatus_CREATING, ClusterStatus_STOPPED:
+		if cluster.Provider == ClusterProvider_BareMetal {
+			cluster.SetBareMetalNode()
+		}
+	case ClusterStatus_RUNNING:
+	default:
+		return nil, errors.Errorf("preflight can not run while the cluster is %s", cluster.Status.String())
+	}
+	nodes := make([]*Node, 0)
+	for _, node := range cluster.Nodes {
+		if node.Ip != "" && !node.DeleteNode() {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("the cluster has no node to check yet")
+	}
+	err = uc.clusterInfrastructure.PreflightNodes(ctx, cluster, nodes)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.clusterData.Save(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const StepProgressKey ContextKey = "step_progress"
+
+// NodeProgress is the progress of a step running on many nodes, it is kept in the data of the step event
+type NodeProgress struct {
+	Total      int     `json:"total"`
+	Done       int     `json:"done"`
+	Failed     int     `json:"failed"`
+	Throughput float64 `json:"throughput"` // nodes finished per minute
+	Remaining  int64   `json:"remaining"`  // estimated seconds left
+}
+
+// NewNodeProgress estimates the throughput and remaining time from the nodes finished since startedAt
+func NewNodeProgress(total, done, failed int, startedAt time.Time) *NodeProgress {
+	progress := &NodeProgress{Total: total, Done: done, Failed: failed}
+	elapsed := time.Since(startedAt)
+	finished := done + failed
+	if finished == 0 || elapsed <= 0 {
+		return progress
+	}
+	progress.Throughput = float64(finished) / elapsed.Minutes()
+	progress.Remaining = int64(elapsed.Seconds() / float64(finished) * float64(total-finished))
+	return progress
+}
+
+// stepProgress holds the reporter of the step running in a cluster event
+type stepProgress struct {
+	mu     sync.Mutex
+	report func(*NodeProgress)
+}
+
+func withStepProgress(ctx context.Context) context.Context {
+	return context.WithValue(ctx, StepProgressKey, &stepProgress{})
+}
+
+func getStepProgress(ctx context.Context) *stepProgress {
+	progress, ok := ctx.Value(StepProgressKey).(*stepProgress)
+	if !ok {
+		return nil
+	}
+	return progress
+}
+
+// swapReporter sets the reporter of the running step and returns the one of the enclosing step
+func (s *stepProgress) swapReporter(report func(*NodeProgress)) func(*NodeProgress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous := s.report
+	s.report = report
+	return previous
+}
+
+// ReportNodeProgress records the progress on the running step, nothing is recorded outside a step
+func ReportNodeProgress(ctx context.Context, progress *NodeProgress) {
+	step := getStepProgress(ctx)
+	if step == nil {
+		return
+	}
+	step.mu.Lock()
+	defer step.mu.Unlock()
+	if step.report != nil {
+		step.report(progress)
+	}
+}
+
+// stepProgressReporter saves the progress in the data of the step event
+func (uc *ClusterUsecase) stepProgressReporter(ctx context.Context, event *Event) func(*NodeProgress) {
+	return func(progress *NodeProgress) {
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return
+		}
+		event.Data = string(data)
+		event.UpdatedAt = time.Now().Format(time.DateTime)
+		if saveErr := uc.clusterData.SaveEvent(ctx, event); saveErr != nil {
+			uc.log.Errorf("failed to record step %s progress: %v", event.Name, saveErr)
+		}
+	}
+}
+
+// GetNodeProgress returns the node progress a step recorded, nil for steps not running on nodes
+func (e *Event) GetNodeProgress() *NodeProgress {
+	if e.Type != EventType_STEP || e.Data == "" {
+		return nil
+	}
+	progress := &NodeProgress{}
+	if err := json.Unmarshal([]byte(e.Data), progress); err != nil {
+		return nil
+	}
+	return progress
+}
@@ -0,0 +1,660 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ClusterSpecApiVersion = "cloud-copilot/v1alpha1"
+	ClusterSpecKind       = "ClusterSpec"
+
+	ClusterSpecFormatYaml = "yaml"
+	ClusterSpecFormatJson = "json"
+
+	PlanResourceNodeGroup = "node_group"
+)
+
+// ClusterSpec is the versioned file a cluster is declared in, empty fields are left to the cluster defaults,
+// node groups and security rules are matched by name and only declared on cloud providers
+type ClusterSpec struct {
+	ApiVersion    string                     `json:"api_version" yaml:"api_version"`
+	Kind          string                     `json:"kind" yaml:"kind"`
+	Name          string                     `json:"name" yaml:"name"`
+	Provider      string                     `json:"provider" yaml:"provider"`
+	Region        string                     `json:"region,omitempty" yaml:"region,omitempty"`
+	Level         string                     `json:"level,omitempty" yaml:"level,omitempty"`
+	Distribution  string                     `json:"distribution,omitempty" yaml:"distribution,omitempty"`
+	ApiServerVip  string                     `json:"api_server_vip,omitempty" yaml:"api_server_vip,omitempty"`
+	NodeUsername  string                     `json:"node_username,omitempty" yaml:"node_username,omitempty"`
+	NodeStartIp   string                     `json:"node_start_ip,omitempty" yaml:"node_start_ip,omitempty"`
+	NodeEndIp     string                     `json:"node_end_ip,omitempty" yaml:"node_end_ip,omitempty"`
+	ProxyJump     string                     `json:"proxy_jump,omitempty" yaml:"proxy_jump,omitempty"`
+	Network       ClusterSpecNetwork         `json:"network,omitempty" yaml:"network,omitempty"`
+	NodeGroups    []*ClusterSpecNodeGroup    `json:"node_groups,omitempty" yaml:"node_groups,omitempty"`
+	SecurityRules []*ClusterSpecSecurityRule `json:"security_rules,omitempty" yaml:"security_rules,omitempty"`
+	Addons        ClusterSpecAddons          `json:"addons,omitempty" yaml:"addons,omitempty"`
+}
+
+type ClusterSpecNetwork struct {
+	VpcCidr     string   `json:"vpc_cidr,omitempty" yaml:"vpc_cidr,omitempty"`
+	PodCidr     string   `json:"pod_cidr,omitempty" yaml:"pod_cidr,omitempty"`
+	ServiceCidr string   `json:"service_cidr,omitempty" yaml:"service_cidr,omitempty"`
+	SubnetCidrs []string `json:"subnet_cidrs,omitempty" yaml:"subnet_cidrs,omitempty"`
+	Domain      string   `json:"domain,omitempty" yaml:"domain,omitempty"`
+}
+
+type ClusterSpecNodeGroup struct {
+	Name       string `json:"name" yaml:"name"`
+	Type       string `json:"type" yaml:"type"`
+	Os         string `json:"os,omitempty" yaml:"os,omitempty"`
+	Arch       string `json:"arch" yaml:"arch"`
+	Cpu        int32  `json:"cpu" yaml:"cpu"`
+	Memory     int32  `json:"memory" yaml:"memory"`
+	Gpu        int32  `json:"gpu,omitempty" yaml:"gpu,omitempty"`
+	GpuSpec    string `json:"gpu_spec,omitempty" yaml:"gpu_spec,omitempty"`
+	MinSize    int32  `json:"min_size" yaml:"min_size"`
+	MaxSize    int32  `json:"max_size" yaml:"max_size"`
+	TargetSize int32  `json:"target_size" yaml:"target_size"`
+}
+
+type ClusterSpecSecurityRule struct {
+	Name      string `json:"name" yaml:"name"`
+	StartPort int32  `json:"start_port" yaml:"start_port"`
+	EndPort   int32  `json:"end_port" yaml:"end_port"`
+	Protocol  string `json:"protocol" yaml:"protocol"`
+	IpCidr    string `json:"ip_cidr" yaml:"ip_cidr"`
+	Access    string `json:"access" yaml:"access"`
+}
+
+// ClusterSpecAddons are the cluster addons services are deployed against
+type ClusterSpecAddons struct {
+	Cni          string `json:"cni,omitempty" yaml:"cni,omitempty"`
+	StorageClass string `json:"storage_class,omitempty" yaml:"storage_class,omitempty"`
+	GatewayClass string `json:"gateway_class,omitempty" yaml:"gateway_class,omitempty"`
+}
+
+type ClusterSpecApplyArgs struct {
+	Spec       *ClusterSpec
+	AccessId   string
+	AccessKey  string
+	PublicKey  string
+	PrivateKey string
+	DryRun     bool
+}
+
+// ParseClusterSpec reads a yaml or json cluster spec, unknown fields are rejected so typos do not pass silently
+func ParseClusterSpec(content string) (*ClusterSpec, error) {
+	spec := &ClusterSpec{}
+	if strings.HasPrefix(strings.TrimSpace(content), "{") {
+		decoder := json.NewDecoder(strings.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(spec)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid json cluster spec")
+		}
+		return spec, nil
+	}
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	decoder.KnownFields(true)
+	err := decoder.Decode(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid yaml cluster spec")
+	}
+	return spec, nil
+}
+
+func (s *ClusterSpec) Marshal(format string) (string, error) {
+	switch format {
+	case ClusterSpecFormatJson:
+		content, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(content) + "\n", nil
+	case ClusterSpecFormatYaml, "":
+		buf := &bytes.Buffer{}
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+		err := encoder.Encode(s)
+		if err != nil {
+			return "", err
+		}
+		err = encoder.Close()
+		if err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	default:
+		return "", errors.Errorf("unsupported cluster spec format %s", format)
+	}
+}
+
+func (s *ClusterSpec) Validate() error {
+	if s.ApiVersion != ClusterSpecApiVersion {
+		return errors.Errorf("unsupported cluster spec api version %q, expected %s", s.ApiVersion, ClusterSpecApiVersion)
+	}
+	if s.Kind != ClusterSpecKind {
+		return errors.Errorf("unsupported cluster spec kind %q, expected %s", s.Kind, ClusterSpecKind)
+	}
+	if s.Name == "" {
+		return errors.New("cluster spec name is required")
+	}
+	provider := ClusterProviderFromString(s.Provider)
+	if provider == 0 {
+		return errors.Errorf("cluster spec provider %q is invalid", s.Provider)
+	}
+	if provider.IsCloud() && s.Region == "" {
+		return errors.New("cluster spec region is required on cloud providers")
+	}
+	if s.Level != "" && ClusterLevelFromString(s.Level) == ClusterLevel_UNSPECIFIED {
+		return errors.Errorf("cluster spec level %q is invalid", s.Level)
+	}
+	if s.Distribution != "" && ClusterDistributionFromString(s.Distribution) == ClusterDistribution_UNSPECIFIED {
+		return errors.Errorf("cluster spec distribution %q is invalid", s.Distribution)
+	}
+	for field, ip := range map[string]string{"api_server_vip": s.ApiServerVip, "node_start_ip": s.NodeStartIp, "node_end_ip": s.NodeEndIp} {
+		if ip != "" && net.ParseIP(ip) == nil {
+			return errors.Errorf("cluster spec %s %q is not an ip", field, ip)
+		}
+	}
+	if _, err := utils.ParseProxyJump(s.ProxyJump); err != nil {
+		return errors.Wrap(err, "cluster spec proxy jump is invalid")
+	}
+	cidrs := append([]string{s.Network.VpcCidr, s.Network.PodCidr, s.Network.ServiceCidr}, s.Network.SubnetCidrs...)
+	for _, cidr := range cidrs {
+		if cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Errorf("cluster spec cidr %q is invalid", cidr)
+		}
+	}
+	if !provider.IsCloud() && (len(s.NodeGroups) != 0 || len(s.SecurityRules) != 0) {
+		return errors.New("node groups and security rules are only declared on cloud providers, other providers discover them from the machines")
+	}
+	names := make(map[string]bool)
+	for _, specNodeGroup := range s.NodeGroups {
+		nodeGroup, err := specNodeGroup.toNodeGroup()
+		if err != nil {
+			return err
+		}
+		err = nodeGroup.Validate()
+		if err != nil {
+			return errors.WithMessagef(err, "node group %s", specNodeGroup.Name)
+		}
+		if names[nodeGroup.Name] {
+			return errors.Errorf("node group %s is declared twice", nodeGroup.Name)
+		}
+		names[nodeGroup.Name] = true
+	}
+	names = make(map[string]bool)
+	for _, rule := range s.SecurityRules {
+		_, err := rule.toSecurity()
+		if err != nil {
+			return err
+		}
+		if names[rule.Name] {
+			return errors.Errorf("security rule %s is declared twice", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+	return nil
+}
+
+func (ng *ClusterSpecNodeGroup) toNodeGroup() (*NodeGroup, error) {
+	nodeGroup := &NodeGroup{
+		Name:       ng.Name,
+		Type:       NodeGroupTypeFromString(ng.Type),
+		Os:         ng.Os,
+		Arch:       NodeArchTypeFromString(ng.Arch),
+		Cpu:        ng.Cpu,
+		Memory:     ng.Memory,
+		Gpu:        ng.Gpu,
+		GpuSpec:    NodeGPUSpecFromString(ng.GpuSpec),
+		MinSize:    ng.MinSize,
+		MaxSize:    ng.MaxSize,
+		TargetSize: ng.TargetSize,
+	}
+	if ng.Type != "" && nodeGroup.Type == NodeGroupType_UNSPECIFIED {
+		return nil, errors.Errorf("node group %s type %q is invalid", ng.Name, ng.Type)
+	}
+	if ng.Arch != "" && nodeGroup.Arch == NodeArchType_UNSPECIFIED {
+		return nil, errors.Errorf("node group %s arch %q is invalid", ng.Name, ng.Arch)
+	}
+	if ng.GpuSpec != "" && nodeGroup.GpuSpec == NodeGPUSpec_UNSPECIFIED {
+		return nil, errors.Errorf("node group %s gpu spec %q is invalid", ng.Name, ng.GpuSpec)
+	}
+	return nodeGroup, nil
+}
+
+func (r *ClusterSpecSecurityRule) toSecurity() (*Security, error) {
+	if r.Name == "" {
+		return nil, errors.New("security rule name is required")
+	}
+	if r.StartPort < 1 || r.EndPort > 65535 || r.StartPort > r.EndPort {
+		return nil, errors.Errorf("security rule %s ports must be between 1 and 65535 and start port not greater than end port", r.Name)
+	}
+	protocol := strings.ToUpper(r.Protocol)
+	if protocol != "TCP" && protocol != "UDP" {
+		return nil, errors.Errorf("security rule %s protocol must be tcp or udp", r.Name)
+	}
+	if _, _, err := net.ParseCIDR(r.IpCidr); err != nil {
+		return nil, errors.Errorf("security rule %s ip cidr %q is invalid", r.Name, r.IpCidr)
+	}
+	access := SecurityAccessFromString(r.Access)
+	if access == SecurityAccess_UNSPECIFIED {
+		return nil, errors.Errorf("security rule %s access must be private or public", r.Name)
+	}
+	return &Security{
+		Name:      r.Name,
+		StartPort: r.StartPort,
+		EndPort:   r.EndPort,
+		Protocol:  protocol,
+		IpCidr:    r.IpCidr,
+		Access:    access,
+	}, nil
+}
+
+// NewClusterSpec exports the cluster as a spec, applying it back changes nothing
+func NewClusterSpec(cluster *Cluster) *ClusterSpec {
+	spec := &ClusterSpec{
+		ApiVersion:   ClusterSpecApiVersion,
+		Kind:         ClusterSpecKind,
+		Name:         cluster.Name,
+		Provider:     cluster.Provider.String(),
+		Region:       cluster.Region,
+		ApiServerVip: cluster.ApiServerVip,
+		NodeUsername: cluster.NodeUsername,
+		NodeStartIp:  cluster.NodeStartIp,
+		NodeEndIp:    cluster.NodeEndIp,
+		ProxyJump:    cluster.ProxyJump,
+		Network: ClusterSpecNetwork{
+			VpcCidr:     cluster.VpcCidr,
+			PodCidr:     cluster.PodCidr,
+			ServiceCidr: cluster.ServiceCidr,
+			Domain:      cluster.Domain,
+		},
+		Addons: ClusterSpecAddons{
+			Cni:          cluster.Cni,
+			StorageClass: cluster.StorageClass,
+			GatewayClass: cluster.GatewayClass,
+		},
+	}
+	if cluster.Level != ClusterLevel_UNSPECIFIED {
+		spec.Level = cluster.Level.String()
+	}
+	if cluster.Distribution != ClusterDistribution_UNSPECIFIED {
+		spec.Distribution = cluster.Distribution.String()
+	}
+	if cluster.SubnetCidrs != "" {
+		spec.Network.SubnetCidrs = strings.Split(cluster.SubnetCidrs, ",")
+	}
+	if !cluster.Provider.IsCloud() {
+		return spec
+	}
+	for _, nodeGroup := range cluster.NodeGroups {
+		specNodeGroup := &ClusterSpecNodeGroup{
+			Name:       nodeGroup.Name,
+			Type:       nodeGroup.Type.String(),
+			Os:         nodeGroup.Os,
+			Arch:       nodeGroup.Arch.String(),
+			Cpu:        nodeGroup.Cpu,
+			Memory:     nodeGroup.Memory,
+			Gpu:        nodeGroup.Gpu,
+			GpuSpec:    nodeGroup.GpuSpec.String(),
+			MinSize:    nodeGroup.MinSize,
+			MaxSize:    nodeGroup.MaxSize,
+			TargetSize: nodeGroup.TargetSize,
+		}
+		spec.NodeGroups = append(spec.NodeGroups, specNodeGroup)
+	}
+	for _, security := range cluster.Securitys {
+		spec.SecurityRules = append(spec.SecurityRules, &ClusterSpecSecurityRule{
+			Name:      security.Name,
+			StartPort: security.StartPort,
+			EndPort:   security.EndPort,
+			Protocol:  security.Protocol,
+			IpCidr:    security.IpCidr,
+			Access:    security.Access.String(),
+		})
+	}
+	return spec
+}
+
+// applyTo copies the declared cluster fields onto the cluster and returns the changed ones
+func (s *ClusterSpec) applyTo(cluster *Cluster) []string {
+	before := *cluster
+	if s.Level != "" {
+		cluster.Level = ClusterLevelFromString(s.Level)
+	}
+	if s.Distribution != "" {
+		cluster.Distribution = ClusterDistributionFromString(s.Distribution)
+	}
+	values := map[*string]string{
+		&cluster.ApiServerVip: s.ApiServerVip,
+		&cluster.NodeUsername: s.NodeUsername,
+		&cluster.NodeStartIp:  s.NodeStartIp,
+		&cluster.NodeEndIp:    s.NodeEndIp,
+		&cluster.ProxyJump:    s.ProxyJump,
+		&cluster.VpcCidr:      s.Network.VpcCidr,
+		&cluster.PodCidr:      s.Network.PodCidr,
+		&cluster.ServiceCidr:  s.Network.ServiceCidr,
+		&cluster.SubnetCidrs:  strings.Join(s.Network.SubnetCidrs, ","),
+		&cluster.Domain:       s.Network.Domain,
+		&cluster.Cni:          s.Addons.Cni,
+		&cluster.StorageClass: s.Addons.StorageClass,
+		&cluster.GatewayClass: s.Addons.GatewayClass,
+	}
+	for field, value := range values {
+		if value != "" {
+			*field = value
+		}
+	}
+	return diffFields(map[string][2]any{
+		"level":          {before.Level, cluster.Level},
+		"distribution":   {before.Distribution, cluster.Distribution},
+		"api_server_vip": {before.ApiServerVip, cluster.ApiServerVip},
+		"node_username":  {before.NodeUsername, cluster.NodeUsername},
+		"node_start_ip":  {before.NodeStartIp, cluster.NodeStartIp},
+		"node_end_ip":    {before.NodeEndIp, cluster.NodeEndIp},
+		"proxy_jump":     {before.ProxyJump, cluster.ProxyJump},
+		"vpc_cidr":       {before.VpcCidr, cluster.VpcCidr},
+		"pod_cidr":       {before.PodCidr, cluster.PodCidr},
+		"service_cidr":   {before.ServiceCidr, cluster.ServiceCidr},
+		"subnet_cidrs":   {before.SubnetCidrs, cluster.SubnetCidrs},
+		"domain":         {before.Domain, cluster.Domain},
+		"cni":            {before.Cni, cluster.Cni},
+		"storage_class":  {before.StorageClass, cluster.StorageClass},
+		"gateway_class":  {before.GatewayClass, cluster.GatewayClass},
+	})
+}
+
+// cluster fields fixed once the cluster is installed, the addons are only deployed by the install
+var clusterSpecInstallFields = []string{"level", "distribution", "api_server_vip", "vpc_cidr", "pod_cidr", "service_cidr",
+	"subnet_cidrs", "domain", "cni", "storage_class", "gateway_class"}
+
+func (uc *ClusterUsecase) ExportClusterSpec(ctx context.Context, clusterId int64) (*ClusterSpec, error) {
+	cluster, err := uc.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return nil, errors.New("cluster not found")
+	}
+	if cluster.ExternallyManaged {
+		return nil, errors.New("imported clusters are managed by their own tooling and have no spec")
+	}
+	return NewClusterSpec(cluster), nil
+}
+
+// ApplyClusterSpec creates the cluster named in the spec or moves the existing one to the spec, applying
+// the same spec twice changes nothing, a dry run only returns the plan
+func (uc *ClusterUsecase) ApplyClusterSpec(ctx context.Context, args *ClusterSpecApplyArgs) (*ClusterPlan, error) {
+	spec := args.Spec
+	err := spec.Validate()
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := uc.clusterData.GetByName(ctx, spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.IsEmpty() {
+		return uc.createClusterFromSpec(ctx, args)
+	}
+	return uc.updateClusterFromSpec(ctx, cluster, args)
+}
+
+func (uc *ClusterUsecase) createClusterFromSpec(ctx context.Context, args *ClusterSpecApplyArgs) (*ClusterPlan, error) {
+	spec := args.Spec
+	provider := ClusterProviderFromString(spec.Provider)
+	if provider != ClusterProvider_Kind && (args.PublicKey == "" || args.PrivateKey == "") {
+		return nil, errors.New("public key and private key are required to create a cluster")
+	}
+	if provider.IsCloud() && (args.AccessId == "" || args.AccessKey == "") {
+		return nil, errors.New("access key id and secret access key are required to create a cloud cluster")
+	}
+	if provider == ClusterProvider_BareMetal && spec.NodeUsername == "" {
+		return nil, errors.New("node username is required to create a bare metal cluster")
+	}
+	if provider == ClusterProvider_BareMetal && (spec.NodeStartIp == "") != (spec.NodeEndIp == "") {
+		return nil, errors.New("start ip and end ip must be set together, leave both empty to use an inventory")
+	}
+	cluster := &Cluster{
+		Name:       spec.Name,
+		Provider:   provider,
+		Region:     spec.Region,
+		PublicKey:  args.PublicKey,
+		PrivateKey: args.PrivateKey,
+		AccessId:   args.AccessId,
+		AccessKey:  args.AccessKey,
+		UserId:     GetUserInfo(ctx).Id,
+	}
+	spec.applyTo(cluster)
+	plan := &ClusterPlan{Status: ClusterStatus_CREATING, Changes: make([]*ClusterPlanChange, 0)}
+	plan.add(PlanResourceCluster, "", cluster.Name, EventAction_CREATE)
+	for _, specNodeGroup := range spec.NodeGroups {
+		nodeGroup, err := specNodeGroup.toNodeGroup()
+		if err != nil {
+			return nil, err
+		}
+		_, err = uc.mergeNodeGroup(ctx, cluster, nodeGroup)
+		if err != nil {
+			return nil, err
+		}
+		plan.add(PlanResourceNodeGroup, "", nodeGroup.Name, EventAction_CREATE)
+	}
+	cluster.Securitys = make([]*Security, 0)
+	for _, rule := range spec.SecurityRules {
+		security, err := rule.toSecurity()
+		if err != nil {
+			return nil, err
+		}
+		security.Id = uuid.NewString()
+		cluster.Securitys = append(cluster.Securitys, security)
+		plan.add(PlanResourceSecurity, "", security.Name, EventAction_CREATE)
+	}
+	if args.DryRun {
+		return plan, nil
+	}
+	err := uc.Save(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	plan.ClusterId = cluster.Id
+	return plan, nil
+}
+
+// updateClusterFromSpec changes the cluster fields before the cluster is installed, node group and node access changes
+// of a running cluster are provisioned through the cluster event and changed security rules through the drift repair
+func (uc *ClusterUsecase) updateClusterFromSpec(ctx context.Context, cluster *Cluster, args *ClusterSpecApplyArgs) (*ClusterPlan, error) {
+	spec := args.Spec
+	if cluster.ExternallyManaged {
+		return nil, errors.New("imported clusters are managed by their own tooling and can not be applied")
+	}
+	if cluster.Provider.String() != spec.Provider {
+		return nil, errors.Errorf("cluster %s provider is %s and can not be changed", cluster.Name, cluster.Provider.String())
+	}
+	if cluster.Provider.IsCloud() && cluster.Region != spec.Region {
+		return nil, errors.Errorf("cluster %s region is %s and can not be changed", cluster.Name, cluster.Region)
+	}
+	plan := &ClusterPlan{ClusterId: cluster.Id, Status: cluster.Status, Changes: make([]*ClusterPlanChange, 0)}
+	clusterFields := spec.applyTo(cluster)
+	if cluster.Status != ClusterStatus_UNSPECIFIED && cluster.Status != ClusterStatus_CREATING {
+		for _, field := range clusterFields {
+			if slices.Contains(clusterSpecInstallFields, field) {
+				return nil, errors.Errorf("cluster %s %s can not be changed after the cluster is installed", cluster.Name, field)
+			}
+		}
+	}
+	if len(clusterFields) != 0 {
+		plan.add(PlanResourceCluster, cast.ToString(cluster.Id), cluster.Name, EventAction_UPDATE, clusterFields...)
+	}
+	nodeGroupChanged, err := uc.applySpecNodeGroups(ctx, plan, cluster, spec)
+	if err != nil {
+		return nil, err
+	}
+	securityChanged := applySpecSecurityRules(plan, cluster, spec)
+	if len(plan.Changes) == 0 || args.DryRun {
+		return plan, nil
+	}
+	switch cluster.Status {
+	case ClusterStatus_UNSPECIFIED, ClusterStatus_CREATING, ClusterStatus_RUNNING, ClusterStatus_STOPPED:
+	default:
+		return nil, errors.Errorf("cluster spec can not be applied while the cluster is %s", cluster.Status.String())
+	}
+	applied := false
+	if nodeGroupChanged || len(clusterFields) != 0 {
+		err = uc.applyNodeGroups(ctx, cluster)
+		applied = cluster.Status == ClusterStatus_RUNNING
+	} else {
+		err = uc.clusterData.Save(ctx, cluster)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !securityChanged || cluster.Status != ClusterStatus_RUNNING {
+		return plan, nil
+	}
+	report, err := uc.detectCloudDrift(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	switch report.Status {
+	case CloudDriftReportStatus_FAILED:
+		return nil, errors.Errorf("security rules are saved but the drift detection failed: %s", report.Error)
+	case CloudDriftReportStatus_DRIFTED:
+		report.Status = CloudDriftReportStatus_REPAIRING
+		err = uc.clusterData.SaveCloudDriftReport(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			break
+		}
+		err = uc.clusterData.Apply(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// applySpecNodeGroups matches the node groups by name, an empty list leaves the node groups as they are
+func (uc *ClusterUsecase) applySpecNodeGroups(ctx context.Context, plan *ClusterPlan, cluster *Cluster, spec *ClusterSpec) (bool, error) {
+	if len(spec.NodeGroups) == 0 {
+		return false, nil
+	}
+	changed := false
+	declared := make(map[string]bool)
+	for _, specNodeGroup := range spec.NodeGroups {
+		declared[specNodeGroup.Name] = true
+		nodeGroup, err := specNodeGroup.toNodeGroup()
+		if err != nil {
+			return false, err
+		}
+		current := cluster.GetNodeGroupByName(nodeGroup.Name)
+		action := EventAction_CREATE
+		fields := make([]string, 0)
+		if current != nil {
+			action = EventAction_UPDATE
+			nodeGroup.Id = current.Id
+			fields = diffFields(map[string][2]any{
+				"type":        {current.Type, nodeGroup.Type},
+				"os":          {current.Os, nodeGroup.Os},
+				"arch":        {current.Arch, nodeGroup.Arch},
+				"cpu":         {current.Cpu, nodeGroup.Cpu},
+				"memory":      {current.Memory, nodeGroup.Memory},
+				"gpu":         {current.Gpu, nodeGroup.Gpu},
+				"gpu_spec":    {current.GpuSpec, nodeGroup.GpuSpec},
+				"min_size":    {current.MinSize, nodeGroup.MinSize},
+				"max_size":    {current.MaxSize, nodeGroup.MaxSize},
+				"target_size": {current.TargetSize, nodeGroup.TargetSize},
+			})
+			if len(fields) == 0 {
+				continue
+			}
+		}
+		_, err = uc.mergeNodeGroup(ctx, cluster, nodeGroup)
+		if err != nil {
+			return false, err
+		}
+		plan.add(PlanResourceNodeGroup, nodeGroup.Id, nodeGroup.Name, action, fields...)
+		changed = true
+	}
+	for _, nodeGroup := range append([]*NodeGroup{}, cluster.NodeGroups...) {
+		if declared[nodeGroup.Name] {
+			continue
+		}
+		err := cluster.removeNodeGroup(nodeGroup)
+		if err != nil {
+			return false, err
+		}
+		plan.add(PlanResourceNodeGroup, nodeGroup.Id, nodeGroup.Name, EventAction_DELETE)
+		changed = true
+	}
+	return changed, nil
+}
+
+// applySpecSecurityRules matches the security rules by name, an empty list leaves the rules as they are
+func applySpecSecurityRules(plan *ClusterPlan, cluster *Cluster, spec *ClusterSpec) bool {
+	if len(spec.SecurityRules) == 0 {
+		return false
+	}
+	changed := false
+	currentSecuritys := make(map[string]*Security)
+	for _, security := range cluster.Securitys {
+		currentSecuritys[security.Name] = security
+	}
+	securitys := make([]*Security, 0)
+	for _, rule := range spec.SecurityRules {
+		// validated with the spec
+		security, _ := rule.toSecurity()
+		current, ok := currentSecuritys[security.Name]
+		if !ok {
+			security.Id = uuid.NewString()
+			security.ClusterId = cluster.Id
+			securitys = append(securitys, security)
+			plan.add(PlanResourceSecurity, "", security.Name, EventAction_CREATE)
+			changed = true
+			continue
+		}
+		delete(currentSecuritys, security.Name)
+		fields := diffFields(map[string][2]any{
+			"start_port": {current.StartPort, security.StartPort},
+			"end_port":   {current.EndPort, security.EndPort},
+			"protocol":   {current.Protocol, security.Protocol},
+			"ip_cidr":    {current.IpCidr, security.IpCidr},
+			"access":     {current.Access, security.Access},
+		})
+		if len(fields) != 0 {
+			current.StartPort = security.StartPort
+			current.EndPort = security.EndPort
+			current.Protocol = security.Protocol
+			current.IpCidr = security.IpCidr
+			current.Access = security.Access
+			plan.add(PlanResourceSecurity, current.Id, current.Name, EventAction_UPDATE, fields...)
+			changed = true
+		}
+		securitys = append(securitys, current)
+	}
+	for _, security := range cluster.Securitys {
+		if _, ok := currentSecuritys[security.Name]; ok {
+			plan.add(PlanResourceSecurity, security.Id, security.Name, EventAction_DELETE)
+			changed = true
+		}
+	}
+	cluster.Securitys = securitys
+	return changed
+}
@@ -377,13 +377,34 @@ type ServiceRuntime interface {
 
 type ServicesUseCase struct {
 	serviceData     ServicesData
+	clusterData     ClusterData
 	serviceRuntime  ServiceRuntime
 	workflowRuntime WorkflowRuntime
 	log             *log.Helper
 }
 
-func NewServicesUseCase(serviceData ServicesData, serviceRuntime ServiceRuntime, wfRuntime WorkflowRuntime, logger log.Logger) *ServicesUseCase {
-	return &ServicesUseCase{serviceData: serviceData, serviceRuntime: serviceRuntime, workflowRuntime: wfRuntime, log: log.NewHelper(logger)}
+func NewServicesUseCase(serviceData ServicesData, clusterData ClusterData, serviceRuntime ServiceRuntime, wfRuntime WorkflowRuntime, logger log.Logger) *ServicesUseCase {
+	return &ServicesUseCase{serviceData: serviceData, clusterData: clusterData, serviceRuntime: serviceRuntime, workflowRuntime: wfRuntime, log: log.NewHelper(logger)}
+}
+
+// withServiceCluster puts the cluster of the service into the context, workflows run on it
+func (uc *ServicesUseCase) withServiceCluster(ctx context.Context, serviceId int64) (context.Context, error) {
+	service, err := uc.serviceData.Get(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+	cluster := GetCluster(ctx)
+	if service.ClusterId == 0 || (cluster != nil && cluster.Id == service.ClusterId) {
+		return ctx, nil
+	}
+	cluster, err = uc.clusterData.Get(ctx, service.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.Errorf("cluster %d of service %s not found", service.ClusterId, service.Name)
+	}
+	return WithCluster(ctx, cluster), nil
 }
 
 func (m MetricPoints) GetFirstValue() float64 {
@@ -550,6 +571,10 @@ func (uc *ServicesUseCase) GetWorkflow(ctx context.Context, serviceId int64, wfT
 }
 
 func (uc *ServicesUseCase) CreateContinuousIntegration(ctx context.Context, ci *ContinuousIntegration) error {
+	ctx, err := uc.withServiceCluster(ctx, ci.ServiceId)
+	if err != nil {
+		return err
+	}
 	service, err := uc.Get(ctx, ci.ServiceId)
 	if err != nil {
 		return err
@@ -585,6 +610,10 @@ func (uc *ServicesUseCase) GetContinuousIntegration(ctx context.Context, ciId in
 	if err != nil {
 		return nil, err
 	}
+	ctx, err = uc.withServiceCluster(ctx, ci.ServiceId)
+	if err != nil {
+		return nil, err
+	}
 	workflow, err := ci.GetWorkflow()
 	if err != nil {
 		return nil, err
@@ -634,6 +663,10 @@ func (uc *ServicesUseCase) DeleteContinuousIntegration(ctx context.Context, ciId
 
 func (uc *ServicesUseCase) CreateContinuousDeployment(ctx context.Context, cd *ContinuousDeployment) error {
 	workspace := GetWorkspace(ctx)
+	ctx, err := uc.withServiceCluster(ctx, cd.ServiceId)
+	if err != nil {
+		return err
+	}
 	service, err := uc.Get(ctx, cd.ServiceId)
 	if err != nil {
 		return err
@@ -674,6 +707,10 @@ func (uc *ServicesUseCase) GetContinuousDeployment(ctx context.Context, cdId int
 	if err != nil {
 		return nil, err
 	}
+	ctx, err = uc.withServiceCluster(ctx, cd.ServiceId)
+	if err != nil {
+		return nil, err
+	}
 	workflow, err := cd.GetWorkflow()
 	if err != nil {
 		return nil, err
@@ -149,6 +149,19 @@ func (uc *WorkspaceUsecase) Save(ctx context.Context, workspace *Workspace) erro
 	if strings.TrimSpace(workspace.Name) == "" {
 		return errors.New("workspace name cannot be empty")
 	}
+	if workspace.Id != 0 {
+		current, err := uc.workspaceData.Get(ctx, workspace.Id)
+		if err != nil {
+			return err
+		}
+		// the tokens are write only, an empty token keeps the stored one
+		if workspace.GitRepositoryToken == "" {
+			workspace.GitRepositoryToken = current.GitRepositoryToken
+		}
+		if workspace.ImageRepositoryToken == "" {
+			workspace.ImageRepositoryToken = current.ImageRepositoryToken
+		}
+	}
 	return uc.workspaceData.Save(ctx, workspace)
 }
 
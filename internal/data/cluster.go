@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/lib"
@@ -15,13 +16,26 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	clusterEventPollInterval = 3 * time.Second
+	clusterEventMaxAttempts  = 5
+	clusterEventBaseBackoff  = 10 * time.Second
+	clusterEventMaxBackoff   = 10 * time.Minute
+	clusterCheckInterval     = time.Minute
+)
+
 type ClusterRepo struct {
 	handlerClusterEvent func(ctx context.Context, cluster *biz.Cluster) error
 	handlerLogs         func(ctx context.Context, key biz.LogType, msg string) error
+	handlerClusterCheck func(ctx context.Context, cluster *biz.Cluster) error
 
-	locks     map[int64]*sync.Mutex
-	locksMux  sync.Mutex
-	eventChan chan *biz.Cluster
+	locks    map[int64]*sync.Mutex
+	locksMux sync.Mutex
+
+	eventNotify chan struct{}
+	stopChan    chan struct{}
+	stopOnce    sync.Once
+	workers     sync.WaitGroup
 
 	data *Data
 	log  *log.Helper
@@ -29,16 +43,22 @@ type ClusterRepo struct {
 
 func NewClusterRepo(data *Data, logger log.Logger) biz.ClusterData {
 	c := &ClusterRepo{
-		data:      data,
-		log:       log.NewHelper(logger),
-		locks:     make(map[int64]*sync.Mutex),
-		locksMux:  sync.Mutex{},
-		eventChan: make(chan *biz.Cluster, 1024),
+		data:        data,
+		log:         log.NewHelper(logger),
+		locks:       make(map[int64]*sync.Mutex),
+		locksMux:    sync.Mutex{},
+		eventNotify: make(chan struct{}, 1),
+		stopChan:    make(chan struct{}),
 	}
 	data.registerRunner(c)
 	return c
 }
 
+// clusterEventData is the payload persisted with a queued cluster event
+type clusterEventData struct {
+	Status biz.ClusterStatus `json:"status"`
+}
+
 type FilebeatLog struct {
 	Host struct {
 		Name string `json:"name"`
@@ -133,6 +153,10 @@ func (c *ClusterRepo) RegisterHandlerLogs(handler func(ctx context.Context, key
 	c.handlerLogs = handler
 }
 
+func (c *ClusterRepo) RegisterHandlerClusterCheck(handler func(ctx context.Context, cluster *biz.Cluster) error) {
+	c.handlerClusterCheck = handler
+}
+
 func (c *ClusterRepo) getLock(clusterID int64) *sync.Mutex {
 	c.locksMux.Lock()
 	defer c.locksMux.Unlock()
@@ -152,12 +176,39 @@ func (c *ClusterRepo) Apply(ctx context.Context, cluster *biz.Cluster) error {
 	if cluster.IsEmpty() {
 		return errors.New("invalid cluster")
 	}
+	data, err := json.Marshal(clusterEventData{Status: cluster.Status})
+	if err != nil {
+		return err
+	}
+	action := biz.EventAction_UPDATE
+	switch cluster.Status {
+	case biz.ClusterStatus_STARTING:
+		action = biz.EventAction_CREATE
+	case biz.ClusterStatus_STOPPING:
+		action = biz.EventAction_DELETE
+	}
+	now := time.Now()
+	event := &biz.Event{
+		Name:      cluster.Name,
+		Type:      biz.EventType_OPERATION,
+		Source:    biz.EventSource_CLUSTER,
+		Action:    action,
+		Status:    biz.EventStatus_PENDING,
+		SourceId:  cluster.Id,
+		Data:      string(data),
+		NextRunAt: now.Unix(),
+		CreatedAt: now.Format(time.DateTime),
+		UpdatedAt: now.Format(time.DateTime),
+	}
+	err = c.data.db.WithContext(ctx).Model(&biz.Event{}).Create(event).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to enqueue cluster event")
+	}
 	select {
-	case c.eventChan <- cluster:
-		return nil
+	case c.eventNotify <- struct{}{}:
 	default:
-		return errors.New("cluster event channel is either full or closed")
 	}
+	return nil
 }
 
 func (c *ClusterRepo) Start(ctx context.Context) error {
@@ -169,29 +220,413 @@ func (c *ClusterRepo) Start(ctx context.Context) error {
 			}
 		}()
 	}
+	// events left in processing were interrupted by the last shutdown
+	err := c.data.db.Model(&biz.Event{}).
+		Where("type = ? and source = ? and status = ?", biz.EventType_OPERATION, biz.EventSource_CLUSTER, biz.EventStatus_PROCESSING).
+		Updates(map[string]any{"status": biz.EventStatus_PENDING, "next_run_at": time.Now().Unix()}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to recover in-flight cluster events")
+	}
+	ticker := time.NewTicker(clusterEventPollInterval)
+	defer ticker.Stop()
+	checkTicker := time.NewTicker(clusterCheckInterval)
+	defer checkTicker.Stop()
 	for {
+		c.dispatchClusterEvents(ctx)
 		select {
 		case <-ctx.Done():
 			return nil
-		case cluster, ok := <-c.eventChan:
-			if !ok {
-				return nil
+		case <-c.stopChan:
+			return nil
+		case <-ticker.C:
+		case <-c.eventNotify:
+		case <-checkTicker.C:
+			c.checkClusters(ctx)
+		}
+	}
+}
+
+// checkClusters hands every running cluster that has no event in progress to the check handler
+func (c *ClusterRepo) checkClusters(ctx context.Context) {
+	if c.handlerClusterCheck == nil {
+		return
+	}
+	clusterIds := make([]int64, 0)
+	err := c.data.db.Model(&biz.Cluster{}).Where("status = ?", biz.ClusterStatus_RUNNING).Pluck("id", &clusterIds).Error
+	if err != nil {
+		c.log.Errorf("failed to list running clusters: %v", err)
+		return
+	}
+	for _, clusterId := range clusterIds {
+		lock := c.getLock(clusterId)
+		if !lock.TryLock() {
+			continue
+		}
+		c.workers.Add(1)
+		go func(clusterId int64) {
+			defer c.workers.Done()
+			defer lock.Unlock()
+			cluster, err := c.Get(ctx, clusterId)
+			if err != nil {
+				c.log.Errorf("failed to get cluster %d: %v", clusterId, err)
+				return
 			}
-			c.getLock(cluster.Id).Lock()
-			err := c.handlerClusterEvent(ctx, cluster)
-			c.getLock(cluster.Id).Unlock()
+			err = c.handlerClusterCheck(ctx, cluster)
 			if err != nil {
-				return err
+				c.log.Errorf("cluster %d check failed: %v", clusterId, err)
 			}
-		}
+		}(clusterId)
 	}
 }
 
 func (c *ClusterRepo) Stop(ctx context.Context) error {
-	close(c.eventChan)
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+	done := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
 	return nil
 }
 
+// dispatchClusterEvents starts the oldest pending event of every idle cluster,
+// later events of the same cluster wait until it succeeds or is dead-lettered
+func (c *ClusterRepo) dispatchClusterEvents(ctx context.Context) {
+	events := make([]*biz.Event, 0)
+	err := c.data.db.Model(&biz.Event{}).
+		Where("type = ? and source = ? and status = ?", biz.EventType_OPERATION, biz.EventSource_CLUSTER, biz.EventStatus_PENDING).
+		Order("id asc").Find(&events).Error
+	if err != nil {
+		c.log.Errorf("failed to list pending cluster events: %v", err)
+		return
+	}
+	now := time.Now().Unix()
+	visited := make(map[int64]bool)
+	for _, event := range events {
+		if visited[event.SourceId] {
+			continue
+		}
+		visited[event.SourceId] = true
+		if event.NextRunAt > now {
+			continue
+		}
+		lock := c.getLock(event.SourceId)
+		if !lock.TryLock() {
+			continue
+		}
+		c.workers.Add(1)
+		go func(event *biz.Event) {
+			defer c.workers.Done()
+			defer lock.Unlock()
+			c.processClusterEvent(ctx, event)
+		}(event)
+	}
+}
+
+func (c *ClusterRepo) processClusterEvent(ctx context.Context, event *biz.Event) {
+	startedAt := time.Now()
+	res := c.data.db.Model(&biz.Event{}).Where("id = ? and status = ?", event.Id, biz.EventStatus_PENDING).
+		Updates(map[string]any{
+			"status":      biz.EventStatus_PROCESSING,
+			"started_at":  startedAt.Format(time.DateTime),
+			"finished_at": "",
+			"updated_at":  startedAt.Format(time.DateTime),
+		})
+	if res.Error != nil {
+		c.log.Errorf("failed to claim cluster event %d: %v", event.Id, res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		return
+	}
+	event.Attempts++
+	event.Status = biz.EventStatus_PROCESSING
+	err := c.runClusterEvent(biz.WithEvent(ctx, event), event)
+	duration := time.Since(startedAt).Milliseconds()
+	if err == nil {
+		c.finishClusterEvent(event, biz.EventStatus_SUCCESS, "", 0, duration)
+		return
+	}
+	c.log.Errorf("cluster %d event %d attempt %d failed: %v", event.SourceId, event.Id, event.Attempts, err)
+	if event.Attempts >= clusterEventMaxAttempts || errors.Is(err, biz.ErrClusterEventAborted) {
+		c.finishClusterEvent(event, biz.EventStatus_DEAD_LETTER, err.Error(), 0, duration)
+		return
+	}
+	c.finishClusterEvent(event, biz.EventStatus_PENDING, err.Error(), time.Now().Add(clusterEventBackoff(event.Attempts)).Unix(), duration)
+}
+
+func (c *ClusterRepo) runClusterEvent(ctx context.Context, event *biz.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("cluster event handler panic: %v", r)
+		}
+	}()
+	if c.handlerClusterEvent == nil {
+		return errors.New("cluster event handler not registered")
+	}
+	cluster, err := c.Get(ctx, event.SourceId)
+	if err != nil {
+		return err
+	}
+	if cluster == nil || cluster.IsEmpty() {
+		return errors.New("cluster not found")
+	}
+	eventData := clusterEventData{}
+	if event.Data != "" {
+		err = json.Unmarshal([]byte(event.Data), &eventData)
+		if err != nil {
+			return err
+		}
+	}
+	// a failed attempt leaves the cluster in error, retry with the requested status
+	if eventData.Status != biz.ClusterStatus_UNSPECIFIED {
+		cluster.SetStatus(eventData.Status)
+	}
+	return c.handlerClusterEvent(ctx, cluster)
+}
+
+func (c *ClusterRepo) finishClusterEvent(event *biz.Event, status biz.EventStatus, errMsg string, nextRunAt, duration int64) {
+	now := time.Now().Format(time.DateTime)
+	err := c.data.db.Model(&biz.Event{}).Where("id = ?", event.Id).Updates(map[string]any{
+		"status":      status,
+		"attempts":    event.Attempts,
+		"error":       errMsg,
+		"next_run_at": nextRunAt,
+		"finished_at": now,
+		"duration":    duration,
+		"updated_at":  now,
+	}).Error
+	if err != nil {
+		c.log.Errorf("failed to update cluster event %d: %v", event.Id, err)
+		return
+	}
+	select {
+	case c.eventNotify <- struct{}{}:
+	default:
+	}
+}
+
+func clusterEventBackoff(attempts int32) time.Duration {
+	backoff := clusterEventBaseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= clusterEventMaxBackoff {
+			return clusterEventMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func (c *ClusterRepo) SaveEvent(ctx context.Context, event *biz.Event) error {
+	if event.Id == 0 {
+		return c.data.db.WithContext(ctx).Model(&biz.Event{}).Create(event).Error
+	}
+	return c.data.db.WithContext(ctx).Model(&biz.Event{}).Where("id = ?", event.Id).Save(event).Error
+}
+
+func (c *ClusterRepo) ListEvents(ctx context.Context, filter *biz.EventFilter) ([]*biz.Event, int64, error) {
+	events := make([]*biz.Event, 0)
+	var total int64
+	query := c.data.db.WithContext(ctx).Model(&biz.Event{}).
+		Where("source = ? and source_id = ?", biz.EventSource_CLUSTER, filter.SourceId)
+	if filter.ParentId != 0 {
+		query = query.Where("parent_id = ?", filter.ParentId)
+	}
+	if filter.Type != biz.EventType_UNSPECIFIED {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Status != biz.EventStatus_UNSPECIFIED {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Name != "" {
+		query = query.Where("name LIKE ?", "%"+filter.Name+"%")
+	}
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return events, total, nil
+	}
+	offset := (filter.Page - 1) * filter.PageSize
+	err = query.Order("id desc").Offset(int(offset)).Limit(int(filter.PageSize)).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (c *ClusterRepo) GetCheckpoints(ctx context.Context, clusterId int64) ([]*biz.ClusterCheckpoint, error) {
+	checkpoints := make([]*biz.ClusterCheckpoint, 0)
+	err := c.data.db.WithContext(ctx).Model(&biz.ClusterCheckpoint{}).Where("cluster_id = ?", clusterId).
+		Order("step asc").Find(&checkpoints).Error
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+func (c *ClusterRepo) SaveCheckpoint(ctx context.Context, checkpoint *biz.ClusterCheckpoint) error {
+	if checkpoint.Id == 0 {
+		return c.data.db.WithContext(ctx).Model(&biz.ClusterCheckpoint{}).Create(checkpoint).Error
+	}
+	return c.data.db.WithContext(ctx).Model(&biz.ClusterCheckpoint{}).Where("id = ?", checkpoint.Id).Save(checkpoint).Error
+}
+
+func (c *ClusterRepo) DeleteCheckpoints(ctx context.Context, clusterId int64) error {
+	return c.data.db.WithContext(ctx).Where("cluster_id = ?", clusterId).Delete(&biz.ClusterCheckpoint{}).Error
+}
+
+func (c *ClusterRepo) GetEtcdBackupPolicy(ctx context.Context, clusterId int64) (*biz.EtcdBackupPolicy, error) {
+	policy := &biz.EtcdBackupPolicy{}
+	err := c.data.db.WithContext(ctx).Model(&biz.EtcdBackupPolicy{}).Where("cluster_id = ?", clusterId).First(policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	policy.S3SecretKey, err = c.data.secrets.Decrypt(policy.S3SecretKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypt s3 secret key of cluster %d", clusterId)
+	}
+	return policy, nil
+}
+
+func (c *ClusterRepo) SaveEtcdBackupPolicy(ctx context.Context, policy *biz.EtcdBackupPolicy) (err error) {
+	secretKey := policy.S3SecretKey
+	policy.S3SecretKey, err = c.data.secrets.Encrypt(secretKey)
+	if err != nil {
+		policy.S3SecretKey = secretKey
+		return err
+	}
+	defer func() {
+		policy.S3SecretKey = secretKey
+	}()
+	if policy.Id == 0 {
+		return c.data.db.WithContext(ctx).Model(&biz.EtcdBackupPolicy{}).Create(policy).Error
+	}
+	return c.data.db.WithContext(ctx).Model(&biz.EtcdBackupPolicy{}).Where("id = ?", policy.Id).Save(policy).Error
+}
+
+// ListEtcdSnapshots returns the snapshots of a cluster newest first
+func (c *ClusterRepo) ListEtcdSnapshots(ctx context.Context, clusterId int64) ([]*biz.EtcdSnapshot, error) {
+	snapshots := make([]*biz.EtcdSnapshot, 0)
+	err := c.data.db.WithContext(ctx).Model(&biz.EtcdSnapshot{}).Where("cluster_id = ?", clusterId).
+		Order("id desc").Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+func (c *ClusterRepo) GetEtcdSnapshot(ctx context.Context, id int64) (*biz.EtcdSnapshot, error) {
+	snapshot := &biz.EtcdSnapshot{}
+	err := c.data.db.WithContext(ctx).Model(&biz.EtcdSnapshot{}).Where("id = ?", id).First(snapshot).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func (c *ClusterRepo) SaveEtcdSnapshot(ctx context.Context, snapshot *biz.EtcdSnapshot) error {
+	if snapshot.Id == 0 {
+		return c.data.db.WithContext(ctx).Model(&biz.EtcdSnapshot{}).Create(snapshot).Error
+	}
+	return c.data.db.WithContext(ctx).Model(&biz.EtcdSnapshot{}).Where("id = ?", snapshot.Id).Save(snapshot).Error
+}
+
+func (c *ClusterRepo) DeleteEtcdSnapshot(ctx context.Context, id int64) error {
+	return c.data.db.WithContext(ctx).Where("id = ?", id).Delete(&biz.EtcdSnapshot{}).Error
+}
+
+func (c *ClusterRepo) GetCloudDriftReport(ctx context.Context, clusterId int64) (*biz.CloudDriftReport, error) {
+	report := &biz.CloudDriftReport{}
+	err := c.data.db.WithContext(ctx).Model(&biz.CloudDriftReport{}).Where("cluster_id = ?", clusterId).First(report).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	report.Drifts = make([]*biz.CloudDrift, 0)
+	err = c.data.db.WithContext(ctx).Model(&biz.CloudDrift{}).Where("cluster_id = ?", clusterId).
+		Order("id asc").Find(&report.Drifts).Error
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// SaveCloudDriftReport replaces the drifts of the cluster with the ones of the report
+func (c *ClusterRepo) SaveCloudDriftReport(ctx context.Context, report *biz.CloudDriftReport) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if report.Id == 0 {
+			err = tx.Model(&biz.CloudDriftReport{}).Create(report).Error
+		} else {
+			err = tx.Model(&biz.CloudDriftReport{}).Where("id = ?", report.Id).Save(report).Error
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Where("cluster_id = ?", report.ClusterId).Delete(&biz.CloudDrift{}).Error
+		if err != nil {
+			return err
+		}
+		for _, drift := range report.Drifts {
+			drift.Id = 0
+			drift.ClusterId = report.ClusterId
+		}
+		if len(report.Drifts) == 0 {
+			return nil
+		}
+		return tx.Model(&biz.CloudDrift{}).Create(report.Drifts).Error
+	})
+}
+
+// ListCertificates returns the certificates of the last check, the first to expire first
+func (c *ClusterRepo) ListCertificates(ctx context.Context, clusterId int64) ([]*biz.Certificate, error) {
+	certificates := make([]*biz.Certificate, 0)
+	err := c.data.db.WithContext(ctx).Model(&biz.Certificate{}).Where("cluster_id = ?", clusterId).
+		Order("expires_at asc, node_name asc, name asc").Find(&certificates).Error
+	if err != nil {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+// SaveCertificates replaces the certificates of the cluster and records when they were checked
+func (c *ClusterRepo) SaveCertificates(ctx context.Context, cluster *biz.Cluster, certificates []*biz.Certificate) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("cluster_id = ?", cluster.Id).Delete(&biz.Certificate{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&biz.Cluster{}).Where("id = ?", cluster.Id).UpdateColumn("certs_checked_at", cluster.CertsCheckedAt).Error
+		if err != nil {
+			return err
+		}
+		for _, certificate := range certificates {
+			certificate.Id = 0
+			certificate.ClusterId = cluster.Id
+		}
+		if len(certificates) == 0 {
+			return nil
+		}
+		return tx.Model(&biz.Certificate{}).Create(certificates).Error
+	})
+}
+
 func (c *ClusterRepo) getLogType(filebeatLog *FilebeatLog) biz.LogType {
 	if filebeatLog == nil {
 		return biz.LogType_UNSPECIFIED
@@ -379,6 +814,16 @@ func (c *ClusterRepo) commitServiceLogs(ctx context.Context, msg string) error {
 }
 
 func (c *ClusterRepo) Save(ctx context.Context, cluster *biz.Cluster) (err error) {
+	kubeConfig, accessKey, privateKey, jumpPrivateKey := cluster.KubeConfig, cluster.AccessKey, cluster.PrivateKey, cluster.JumpPrivateKey
+	defer func() {
+		cluster.KubeConfig, cluster.AccessKey, cluster.PrivateKey, cluster.JumpPrivateKey = kubeConfig, accessKey, privateKey, jumpPrivateKey
+	}()
+	for _, secret := range []*string{&cluster.KubeConfig, &cluster.AccessKey, &cluster.PrivateKey, &cluster.JumpPrivateKey} {
+		*secret, err = c.data.secrets.Encrypt(*secret)
+		if err != nil {
+			return err
+		}
+	}
 	tx := c.data.db.Begin()
 	defer func() {
 		if err != nil {
@@ -423,6 +868,11 @@ func (c *ClusterRepo) Get(ctx context.Context, id int64) (*biz.Cluster, error) {
 	if cluster.Id == 0 {
 		return nil, nil
 	}
+	err = c.decryptCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	cluster.InstanceId = c.data.instanceId
 	nodeGroups := make([]*biz.NodeGroup, 0)
 	err = c.data.db.Model(&biz.NodeGroup{}).Where("cluster_id = ?", cluster.Id).Find(&nodeGroups).Error
 	if err != nil {
@@ -455,6 +905,30 @@ func (c *ClusterRepo) Get(ctx context.Context, id int64) (*biz.Cluster, error) {
 	if len(securitys) != 0 {
 		cluster.Securitys = securitys
 	}
+	inventoryHosts := make([]*biz.InventoryHost, 0)
+	err = c.data.db.Model(&biz.InventoryHost{}).Where("cluster_id = ?", cluster.Id).Order("host").Find(&inventoryHosts).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(inventoryHosts) != 0 {
+		cluster.InventoryHosts = inventoryHosts
+	}
+	nodeCredentials := make([]*biz.NodeCredential, 0)
+	err = c.data.db.Model(&biz.NodeCredential{}).Where("cluster_id = ?", cluster.Id).Order("name").Find(&nodeCredentials).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range nodeCredentials {
+		for _, secret := range []*string{&credential.PrivateKey, &credential.Password} {
+			*secret, err = c.data.secrets.Decrypt(*secret)
+			if err != nil {
+				return nil, errors.Wrapf(err, "decrypt node credential %s of cluster %s", credential.Name, cluster.Name)
+			}
+		}
+	}
+	if len(nodeCredentials) != 0 {
+		cluster.NodeCredentials = nodeCredentials
+	}
 	disks := make([]*biz.Disk, 0)
 	err = c.data.db.Model(&biz.Disk{}).Where("cluster_id =?", cluster.Id).Find(&disks).Error
 	if err != nil {
@@ -485,15 +959,36 @@ func (c *ClusterRepo) GetByName(ctx context.Context, name string) (*biz.Cluster,
 	return cluster, nil
 }
 
+func (c *ClusterRepo) GetInstanceId() string {
+	return c.data.instanceId
+}
+
 func (c *ClusterRepo) GetClustersByIds(ctx context.Context, ids []int64) ([]*biz.Cluster, error) {
 	clusters := make([]*biz.Cluster, 0)
 	err := c.data.db.Model(&biz.Cluster{}).Where("id IN ?", ids).Find(&clusters).Error
 	if err != nil {
 		return nil, err
 	}
+	for _, cluster := range clusters {
+		err = c.decryptCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return clusters, nil
 }
 
+// decryptCluster opens the kubeconfig and credentials sealed by Save
+func (c *ClusterRepo) decryptCluster(cluster *biz.Cluster) (err error) {
+	for _, secret := range []*string{&cluster.KubeConfig, &cluster.AccessKey, &cluster.PrivateKey, &cluster.JumpPrivateKey} {
+		*secret, err = c.data.secrets.Decrypt(*secret)
+		if err != nil {
+			return errors.Wrapf(err, "decrypt credentials of cluster %s", cluster.Name)
+		}
+	}
+	return nil
+}
+
 func (c *ClusterRepo) List(ctx context.Context, name string, page, pageSize int32) ([]*biz.Cluster, int64, error) {
 	var clusters []*biz.Cluster
 	var total int64
@@ -514,6 +1009,12 @@ func (c *ClusterRepo) List(ctx context.Context, name string, page, pageSize int3
 	if err != nil {
 		return nil, 0, err
 	}
+	for _, cluster := range clusters {
+		err = c.decryptCluster(cluster)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
 
 	return clusters, total, nil
 }
@@ -545,10 +1046,31 @@ func (c *ClusterRepo) Delete(ctx context.Context, id int64) (err error) {
 	if err != nil {
 		return err
 	}
+	err = tx.Model(&biz.InventoryHost{}).Where("cluster_id = ?", id).Delete(&biz.InventoryHost{}).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Model(&biz.NodeCredential{}).Where("cluster_id = ?", id).Delete(&biz.NodeCredential{}).Error
+	if err != nil {
+		return err
+	}
 	err = tx.Model(&biz.Disk{}).Where("cluster_id =?", id).Delete(&biz.Disk{}).Error
 	if err != nil {
 		return err
 	}
+	err = tx.Model(&biz.ClusterCheckpoint{}).Where("cluster_id = ?", id).Delete(&biz.ClusterCheckpoint{}).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Model(&biz.Certificate{}).Where("cluster_id = ?", id).Delete(&biz.Certificate{}).Error
+	if err != nil {
+		return err
+	}
+	// snapshots stay listed by cluster id so their files can still be found after the cluster is gone
+	err = tx.Model(&biz.EtcdBackupPolicy{}).Where("cluster_id = ?", id).Delete(&biz.EtcdBackupPolicy{}).Error
+	if err != nil {
+		return err
+	}
 	return tx.Commit().Error
 }
 
@@ -676,6 +1198,59 @@ func (c *ClusterRepo) saveSecuritys(_ context.Context, cluster *biz.Cluster, tx
 	return nil
 }
 
+// SaveInventory replaces the inventory hosts and node credentials of the cluster, Save leaves them alone
+func (c *ClusterRepo) SaveInventory(ctx context.Context, cluster *biz.Cluster) error {
+	return c.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := c.saveInventoryHosts(ctx, cluster, tx)
+		if err != nil {
+			return err
+		}
+		return c.saveNodeCredentials(ctx, cluster, tx)
+	})
+}
+
+func (c *ClusterRepo) saveInventoryHosts(_ context.Context, cluster *biz.Cluster, tx *gorm.DB) error {
+	ids := make([]string, 0)
+	for _, v := range cluster.InventoryHosts {
+		v.ClusterId = cluster.Id
+		err := tx.Model(&biz.InventoryHost{}).Where("id = ?", v.Id).Save(v).Error
+		if err != nil {
+			return err
+		}
+		ids = append(ids, v.Id)
+	}
+	query := tx.Model(&biz.InventoryHost{}).Where("cluster_id = ?", cluster.Id)
+	if len(ids) != 0 {
+		query = query.Where("id NOT IN ?", ids)
+	}
+	return query.Delete(&biz.InventoryHost{}).Error
+}
+
+// saveNodeCredentials seals the secrets of a copy, the cluster keeps the plain credentials
+func (c *ClusterRepo) saveNodeCredentials(_ context.Context, cluster *biz.Cluster, tx *gorm.DB) (err error) {
+	ids := make([]string, 0)
+	for _, v := range cluster.NodeCredentials {
+		v.ClusterId = cluster.Id
+		credential := *v
+		for _, secret := range []*string{&credential.PrivateKey, &credential.Password} {
+			*secret, err = c.data.secrets.Encrypt(*secret)
+			if err != nil {
+				return err
+			}
+		}
+		err = tx.Model(&biz.NodeCredential{}).Where("id = ?", v.Id).Save(&credential).Error
+		if err != nil {
+			return err
+		}
+		ids = append(ids, v.Id)
+	}
+	query := tx.Model(&biz.NodeCredential{}).Where("cluster_id = ?", cluster.Id)
+	if len(ids) != 0 {
+		query = query.Where("id NOT IN ?", ids)
+	}
+	return query.Delete(&biz.NodeCredential{}).Error
+}
+
 // save disk
 func (c *ClusterRepo) saveDisk(_ context.Context, cluster *biz.Cluster, tx *gorm.DB) error {
 	for _, node := range cluster.Nodes {
@@ -9,11 +9,14 @@ import (
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/internal/conf"
 	"github.com/f-rambo/cloud-copilot/lib"
+	"github.com/f-rambo/cloud-copilot/utils"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/google/wire"
 	"github.com/pkg/errors"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	gormlogger "gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
 )
@@ -51,6 +54,8 @@ type Data struct {
 	log           *log.Helper
 	db            *gorm.DB
 	dbLoggerLevel gormlogger.LogLevel
+	secrets       *utils.SecretBox
+	instanceId    string
 
 	kafkaConsumer    *lib.KafkaConsumer
 	prometheusClient *lib.PrometheusClient
@@ -78,6 +83,9 @@ func NewData(ctx context.Context, c *conf.Bootstrap, logger log.Logger) (*Data,
 
 	syncOnce := new(sync.Once)
 	syncOnce.Do(func() {
+		if err = data.newSecretBox(); err != nil {
+			return
+		}
 		if err = data.newDatabase(); err != nil {
 			return
 		}
@@ -112,6 +120,77 @@ func (d *Data) Clean() {
 	}
 }
 
+// authKeyId is the key id of the key derived from the auth key when no key file is configured
+const authKeyId = "auth"
+
+// newSecretBox loads the keys the stored credentials are sealed with, values sealed with the auth key
+// before envelope encryption stay readable
+func (d *Data) newSecretBox() error {
+	var (
+		keys *utils.LocalKeyProvider
+		err  error
+	)
+	if d.conf.Encryption != nil && d.conf.Encryption.KeyFile != "" {
+		keys, err = utils.LoadLocalKeyProvider(d.conf.Encryption.KeyFile)
+	} else {
+		keys, err = utils.NewSecretKeyProvider(authKeyId, d.conf.Auth.GetKey())
+	}
+	if err != nil {
+		return errors.Wrap(err, "load encryption keys failed")
+	}
+	// values sealed before the key file was configured are opened until rotate-keys re-sealed them
+	keys.AddSecretKey(authKeyId, d.conf.Auth.GetKey())
+	d.secrets = utils.NewSecretBox(keys, d.conf.Auth.GetKey())
+	return nil
+}
+
+// RotateSecrets seals every stored secret that is plaintext or sealed with an older key with the current key
+func (d *Data) RotateSecrets(ctx context.Context) (int, error) {
+	rotated := 0
+	tables := []struct {
+		model   any
+		columns []string
+	}{
+		{&biz.Cluster{}, []string{"kube_config", "access_key", "private_key", "jump_private_key"}},
+		{&biz.NodeCredential{}, []string{"private_key", "password"}},
+		{&biz.EtcdBackupPolicy{}, []string{"s3_secret_key"}},
+		{&biz.Workspace{}, []string{"gitrepository_token", "imagerepository_token"}},
+	}
+	for _, table := range tables {
+		rows := make([]map[string]any, 0)
+		err := d.db.WithContext(ctx).Model(table.model).Select(append([]string{"id"}, table.columns...)).Find(&rows).Error
+		if err != nil {
+			return rotated, err
+		}
+		for _, row := range rows {
+			updates := make(map[string]any)
+			for _, column := range table.columns {
+				value, _ := row[column].(string)
+				if !d.secrets.NeedsRotation(value) {
+					continue
+				}
+				plaintext, err := d.secrets.Decrypt(value)
+				if err != nil {
+					return rotated, errors.Wrapf(err, "decrypt %s of row %v", column, row["id"])
+				}
+				updates[column], err = d.secrets.Encrypt(plaintext)
+				if err != nil {
+					return rotated, err
+				}
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			err = d.db.WithContext(ctx).Model(table.model).Where("id = ?", row["id"]).UpdateColumns(updates).Error
+			if err != nil {
+				return rotated, err
+			}
+			rotated++
+		}
+	}
+	return rotated, nil
+}
+
 func (d *Data) newDatabase() error {
 
 	c := d.conf
@@ -160,7 +239,17 @@ func (d *Data) newDatabase() error {
 		&biz.NodeGroup{},
 		&biz.CloudResource{},
 		&biz.Security{},
+		&biz.InventoryHost{},
+		&biz.NodeCredential{},
 		&biz.Disk{},
+		&biz.Event{},
+		&biz.ClusterCheckpoint{},
+		&biz.EtcdBackupPolicy{},
+		&biz.EtcdSnapshot{},
+		&biz.CloudDriftReport{},
+		&biz.CloudDrift{},
+		&biz.Certificate{},
+		&biz.Instance{},
 		&biz.Project{},
 		&biz.Service{},
 		&biz.Port{},
@@ -182,6 +271,26 @@ func (d *Data) newDatabase() error {
 	if err != nil {
 		return errors.Wrap(err, "auto migrate failed")
 	}
+	return d.newInstanceId()
+}
+
+// newInstanceId loads the id of this installation, the first start creates it, installations sharing
+// a cloud account tell their resources apart by it
+func (d *Data) newInstanceId() error {
+	err := d.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&biz.Instance{
+		Id:         1,
+		InstanceId: uuid.NewString(),
+		CreatedAt:  time.Now().Format(time.DateTime),
+	}).Error
+	if err != nil {
+		return errors.Wrap(err, "create instance id failed")
+	}
+	instance := &biz.Instance{}
+	err = d.db.Model(&biz.Instance{}).Where("id = ?", 1).First(instance).Error
+	if err != nil {
+		return errors.Wrap(err, "load instance id failed")
+	}
+	d.instanceId = instance.InstanceId
 	return nil
 }
 
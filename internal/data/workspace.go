@@ -5,6 +5,7 @@ import (
 
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -33,10 +34,24 @@ func (w *workspaceRepo) Get(ctx context.Context, id int64) (*biz.Workspace, erro
 		return nil, err
 	}
 	workspace.WorkspaceClusterRelationships = workspaceClusterRelationships
+	err = w.decryptWorkspace(workspace)
+	if err != nil {
+		return nil, err
+	}
 	return workspace, nil
 }
 
-func (w *workspaceRepo) Save(ctx context.Context, workspace *biz.Workspace) error {
+func (w *workspaceRepo) Save(ctx context.Context, workspace *biz.Workspace) (err error) {
+	gitToken, imageToken := workspace.GitRepositoryToken, workspace.ImageRepositoryToken
+	defer func() {
+		workspace.GitRepositoryToken, workspace.ImageRepositoryToken = gitToken, imageToken
+	}()
+	for _, secret := range []*string{&workspace.GitRepositoryToken, &workspace.ImageRepositoryToken} {
+		*secret, err = w.data.secrets.Encrypt(*secret)
+		if err != nil {
+			return err
+		}
+	}
 	return w.data.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Save(workspace).Error; err != nil {
 			return err
@@ -99,6 +114,12 @@ func (w *workspaceRepo) List(ctx context.Context, workspaceName string, page, si
 	if err != nil {
 		return nil, 0, err
 	}
+	for _, workspace := range workspaces {
+		err = w.decryptWorkspace(workspace)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
 
 	workspaceIds := make([]int64, 0)
 	for _, v := range workspaces {
@@ -132,9 +153,23 @@ func (w *workspaceRepo) GetByName(ctx context.Context, name string) (*biz.Worksp
 	if err := w.data.db.Where("name = ?", name).First(workspace).Error; err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	}
+	if err := w.decryptWorkspace(workspace); err != nil {
+		return nil, err
+	}
 	return workspace, nil
 }
 
+// decryptWorkspace opens the repository tokens sealed by Save
+func (w *workspaceRepo) decryptWorkspace(workspace *biz.Workspace) (err error) {
+	for _, secret := range []*string{&workspace.GitRepositoryToken, &workspace.ImageRepositoryToken} {
+		*secret, err = w.data.secrets.Decrypt(*secret)
+		if err != nil {
+			return errors.Wrapf(err, "decrypt repository tokens of workspace %s", workspace.Name)
+		}
+	}
+	return nil
+}
+
 func (w *workspaceRepo) Delete(ctx context.Context, workspace *biz.Workspace) error {
 	return w.data.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Where("workspace_id = ?", workspace.Id).Delete(&biz.WorkspaceClusterRelationship{}).Error; err != nil {
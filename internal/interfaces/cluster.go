@@ -2,11 +2,13 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/f-rambo/cloud-copilot/api/cluster/v1alpha1"
 	"github.com/f-rambo/cloud-copilot/api/common"
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/internal/conf"
+	"github.com/f-rambo/cloud-copilot/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -15,13 +17,15 @@ import (
 type ClusterInterface struct {
 	v1alpha1.UnimplementedClusterInterfaceServer
 	clusterUc *biz.ClusterUsecase
+	gcUc      *biz.CloudResourceGCUsecase
 	c         *conf.Bootstrap
 	log       *log.Helper
 }
 
-func NewClusterInterface(clusterUc *biz.ClusterUsecase, c *conf.Bootstrap, logger log.Logger) *ClusterInterface {
+func NewClusterInterface(clusterUc *biz.ClusterUsecase, gcUc *biz.CloudResourceGCUsecase, c *conf.Bootstrap, logger log.Logger) *ClusterInterface {
 	return &ClusterInterface{
 		clusterUc: clusterUc,
+		gcUc:      gcUc,
 		c:         c,
 		log:       log.NewHelper(logger),
 	}
@@ -153,17 +157,37 @@ func (c *ClusterInterface) Get(ctx context.Context, clusterArgs *v1alpha1.Cluste
 	return c.bizCLusterToCluster(cluster), nil
 }
 func (c *ClusterInterface) Save(ctx context.Context, clusterArgs *v1alpha1.ClusterSaveArgs) (*v1alpha1.Cluster, error) {
-	if clusterArgs.Name == "" || clusterArgs.PrivateKey == "" || clusterArgs.Provider == "" || clusterArgs.PublicKey == "" {
-		return nil, errors.New("cluster name, private key, type and public key are required")
+	if clusterArgs.Name == "" || clusterArgs.Provider == "" {
+		return nil, errors.New("cluster name and type are required")
 	}
 	if biz.ClusterProviderFromString(clusterArgs.Provider) == 0 {
 		return nil, errors.New("cluster type is invalid")
 	}
-	if biz.ClusterProviderFromString(clusterArgs.Provider).IsCloud() && (clusterArgs.AccessId == "" || clusterArgs.AccessKey == "" || clusterArgs.Region == "") {
+	// the private key and the secret access key are write only, empty keeps the stored ones on update,
+	// kind runs locally and never connects over ssh
+	if biz.ClusterProviderFromString(clusterArgs.Provider) != biz.ClusterProvider_Kind &&
+		((clusterArgs.Id == 0 && clusterArgs.PrivateKey == "") || clusterArgs.PublicKey == "") {
+		return nil, errors.New("private key and public key are required")
+	}
+	if biz.ClusterProviderFromString(clusterArgs.Provider).IsCloud() && (clusterArgs.AccessId == "" || (clusterArgs.Id == 0 && clusterArgs.AccessKey == "") || clusterArgs.Region == "") {
 		return nil, errors.New("access key id and secret access key, region are required")
 	}
-	if !biz.ClusterProviderFromString(clusterArgs.Provider).IsCloud() && (clusterArgs.NodeUsername == "" || clusterArgs.NodeStartIp == "" || clusterArgs.NodeEndIp == "") {
-		return nil, errors.New("node username, start ip and end ip are required")
+	if biz.ClusterProviderFromString(clusterArgs.Provider) == biz.ClusterProvider_BareMetal && clusterArgs.NodeUsername == "" {
+		return nil, errors.New("node username is required")
+	}
+	if (clusterArgs.NodeStartIp == "") != (clusterArgs.NodeEndIp == "") {
+		return nil, errors.New("start ip and end ip must be set together, leave both empty to use an inventory")
+	}
+	if _, err := utils.ParseProxyJump(clusterArgs.ProxyJump); err != nil {
+		return nil, errors.Wrap(err, "proxy jump is invalid")
+	}
+	level := biz.ClusterLevelFromString(clusterArgs.Level)
+	if clusterArgs.Level != "" && level == biz.ClusterLevel_UNSPECIFIED {
+		return nil, errors.New("cluster level is invalid")
+	}
+	distribution := biz.ClusterDistributionFromString(clusterArgs.Distribution)
+	if clusterArgs.Distribution != "" && distribution == biz.ClusterDistribution_UNSPECIFIED {
+		return nil, errors.New("cluster distribution is invalid")
 	}
 	if clusterArgs.Id != 0 {
 		clusterRes, err := c.clusterUc.Get(ctx, int64(clusterArgs.Id))
@@ -173,6 +197,12 @@ func (c *ClusterInterface) Save(ctx context.Context, clusterArgs *v1alpha1.Clust
 		if clusterRes == nil || clusterRes.Id == 0 {
 			return nil, errors.New("cluster not found")
 		}
+		if distribution == biz.ClusterDistribution_UNSPECIFIED {
+			distribution = clusterRes.Distribution
+		}
+		if distribution != clusterRes.Distribution && clusterRes.Status != biz.ClusterStatus_CREATING {
+			return nil, errors.New("cluster distribution cannot be changed after the cluster is installed")
+		}
 	} else {
 		clusterRes, err := c.clusterUc.GetByName(ctx, clusterArgs.Name)
 		if err != nil {
@@ -183,18 +213,23 @@ func (c *ClusterInterface) Save(ctx context.Context, clusterArgs *v1alpha1.Clust
 		}
 	}
 	cluster := &biz.Cluster{
-		Id:           int64(clusterArgs.Id),
-		Name:         clusterArgs.Name,
-		Provider:     biz.ClusterProviderFromString(clusterArgs.Provider),
-		PublicKey:    clusterArgs.PublicKey,
-		PrivateKey:   clusterArgs.PrivateKey,
-		AccessId:     clusterArgs.AccessId,
-		AccessKey:    clusterArgs.AccessKey,
-		Region:       clusterArgs.Region,
-		NodeUsername: clusterArgs.NodeUsername,
-		NodeStartIp:  clusterArgs.NodeStartIp,
-		NodeEndIp:    clusterArgs.NodeEndIp,
-		UserId:       biz.GetUserInfo(ctx).Id,
+		Id:             int64(clusterArgs.Id),
+		Name:           clusterArgs.Name,
+		Provider:       biz.ClusterProviderFromString(clusterArgs.Provider),
+		PublicKey:      clusterArgs.PublicKey,
+		PrivateKey:     clusterArgs.PrivateKey,
+		AccessId:       clusterArgs.AccessId,
+		AccessKey:      clusterArgs.AccessKey,
+		Region:         clusterArgs.Region,
+		NodeUsername:   clusterArgs.NodeUsername,
+		NodeStartIp:    clusterArgs.NodeStartIp,
+		NodeEndIp:      clusterArgs.NodeEndIp,
+		Level:          level,
+		Distribution:   distribution,
+		ApiServerVip:   clusterArgs.ApiServerVip,
+		ProxyJump:      clusterArgs.ProxyJump,
+		JumpPrivateKey: clusterArgs.JumpPrivateKey,
+		UserId:         biz.GetUserInfo(ctx).Id,
 	}
 	err := c.clusterUc.Save(ctx, cluster)
 	if err != nil {
@@ -267,6 +302,188 @@ func (c *ClusterInterface) GetRegions(ctx context.Context, clusterArgs *v1alpha1
 	return &v1alpha1.Regions{Regions: data}, nil
 }
 
+func (c *ClusterInterface) ListEvents(ctx context.Context, args *v1alpha1.ClusterEventListArgs) (*v1alpha1.ClusterEventList, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	filter := &biz.EventFilter{
+		SourceId: int64(args.ClusterId),
+		ParentId: int64(args.ParentId),
+		Name:     args.Name,
+		Page:     args.Page,
+		PageSize: args.PageSize,
+	}
+	if args.Type != "" {
+		filter.Type = biz.EventTypeFromString(args.Type)
+		if filter.Type == biz.EventType_UNSPECIFIED {
+			return nil, errors.New("event type is invalid")
+		}
+	}
+	if args.Status != "" {
+		filter.Status = biz.EventStatusFromString(args.Status)
+		if filter.Status == biz.EventStatus_UNSPECIFIED {
+			return nil, errors.New("event status is invalid")
+		}
+	}
+	events, total, err := c.clusterUc.ListEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.ClusterEventList{Events: make([]*v1alpha1.ClusterEvent, 0), Total: int32(total)}
+	for _, event := range events {
+		data.Events = append(data.Events, c.bizEventToClusterEvent(event))
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) GetProvisionSteps(ctx context.Context, clusterArgs *v1alpha1.ClusterIdArgs) (*v1alpha1.ClusterProvisionSteps, error) {
+	if clusterArgs.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	checkpoints, err := c.clusterUc.GetProvisionSteps(ctx, int64(clusterArgs.Id))
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.ClusterProvisionSteps{Steps: make([]*v1alpha1.ClusterProvisionStep, 0)}
+	for _, checkpoint := range checkpoints {
+		data.Steps = append(data.Steps, &v1alpha1.ClusterProvisionStep{
+			Step:      checkpoint.Step.String(),
+			Status:    checkpoint.Status.String(),
+			Error:     checkpoint.Error,
+			Attempts:  checkpoint.Attempts,
+			UpdatedAt: checkpoint.UpdatedAt,
+		})
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) RetryProvisionStep(ctx context.Context, args *v1alpha1.ClusterProvisionStepArgs) (*common.Msg, error) {
+	step, err := c.checkProvisionStepArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	err = c.clusterUc.RetryProvisionStep(ctx, int64(args.ClusterId), step)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) SkipProvisionStep(ctx context.Context, args *v1alpha1.ClusterProvisionStepArgs) (*common.Msg, error) {
+	step, err := c.checkProvisionStepArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	err = c.clusterUc.SkipProvisionStep(ctx, int64(args.ClusterId), step)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) checkProvisionStepArgs(args *v1alpha1.ClusterProvisionStepArgs) (biz.ClusterStep, error) {
+	if args.ClusterId == 0 {
+		return biz.ClusterStep_UNSPECIFIED, errors.New("cluster id is required")
+	}
+	step := biz.ClusterStepFromString(args.Step)
+	if step == biz.ClusterStep_UNSPECIFIED {
+		return step, errors.New("provision step is invalid")
+	}
+	return step, nil
+}
+
+func (c *ClusterInterface) Plan(ctx context.Context, args *v1alpha1.ClusterPlanArgs) (*v1alpha1.ClusterPlan, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	var status biz.ClusterStatus
+	switch args.Operation {
+	case "start":
+		status = biz.ClusterStatus_STARTING
+	case "stop":
+		status = biz.ClusterStatus_STOPPING
+	default:
+		return nil, errors.New("operation must be start or stop")
+	}
+	plan, err := c.clusterUc.Plan(ctx, int64(args.ClusterId), status)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizPlanToPlan(plan, args.Operation), nil
+}
+
+func (c *ClusterInterface) ExportClusterSpec(ctx context.Context, args *v1alpha1.ClusterSpecExportArgs) (*v1alpha1.ClusterSpecFile, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	format := args.Format
+	if format == "" {
+		format = biz.ClusterSpecFormatYaml
+	}
+	if format != biz.ClusterSpecFormatYaml && format != biz.ClusterSpecFormatJson {
+		return nil, errors.New("format must be yaml or json")
+	}
+	spec, err := c.clusterUc.ExportClusterSpec(ctx, int64(args.ClusterId))
+	if err != nil {
+		return nil, err
+	}
+	content, err := spec.Marshal(format)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ClusterSpecFile{Format: format, Content: content}, nil
+}
+
+func (c *ClusterInterface) ApplyClusterSpec(ctx context.Context, args *v1alpha1.ClusterSpecApplyArgs) (*v1alpha1.ClusterPlan, error) {
+	if args.Spec == "" {
+		return nil, errors.New("cluster spec is required")
+	}
+	spec, err := biz.ParseClusterSpec(args.Spec)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := c.clusterUc.ApplyClusterSpec(ctx, &biz.ClusterSpecApplyArgs{
+		Spec:       spec,
+		AccessId:   args.AccessId,
+		AccessKey:  args.AccessKey,
+		PublicKey:  args.PublicKey,
+		PrivateKey: args.PrivateKey,
+		DryRun:     args.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.bizPlanToPlan(plan, "apply"), nil
+}
+
+func (c *ClusterInterface) bizPlanToPlan(plan *biz.ClusterPlan, operation string) *v1alpha1.ClusterPlan {
+	data := &v1alpha1.ClusterPlan{
+		ClusterId: int32(plan.ClusterId),
+		Operation: operation,
+		ToCreate:  plan.Count(biz.EventAction_CREATE),
+		ToUpdate:  plan.Count(biz.EventAction_UPDATE),
+		ToDelete:  plan.Count(biz.EventAction_DELETE),
+		Resources: make([]*v1alpha1.ClusterPlanResource, 0),
+	}
+	for _, resourceType := range plan.ResourceTypes() {
+		resource := &v1alpha1.ClusterPlanResource{ResourceType: resourceType, Changes: make([]*v1alpha1.ClusterPlanChange, 0)}
+		for _, change := range plan.Changes {
+			if change.ResourceType != resourceType {
+				continue
+			}
+			resource.Changes = append(resource.Changes, &v1alpha1.ClusterPlanChange{
+				Action: change.Action.String(),
+				Id:     change.Id,
+				Name:   change.Name,
+				Fields: change.Fields,
+			})
+		}
+		data.Resources = append(data.Resources, resource)
+	}
+	return data
+}
+
+// the private key is write only
 func (c *ClusterInterface) bizCLusterToCluster(bizCluster *biz.Cluster) *v1alpha1.Cluster {
 	nodes := make([]*v1alpha1.Node, 0)
 	for _, v := range bizCluster.Nodes {
@@ -283,22 +500,26 @@ func (c *ClusterInterface) bizCLusterToCluster(bizCluster *biz.Cluster) *v1alpha
 		nodeGroups = append(nodeGroups, c.bizNodeGroupToNodeGroup(v))
 	}
 	return &v1alpha1.Cluster{
-		Id:               int32(bizCluster.Id),
-		Name:             bizCluster.Name,
-		ApiServerAddress: bizCluster.ApiServerAddress,
-		Status:           bizCluster.Status.String(),
-		Level:            bizCluster.Level.String(),
-		Domain:           bizCluster.Domain,
-		NodeNumber:       int32(len(bizCluster.Nodes)),
-		Provider:         bizCluster.Provider.String(),
-		Region:           bizCluster.Region,
-		NodeUsername:     bizCluster.NodeUsername,
-		NodeStartIp:      bizCluster.NodeStartIp,
-		NodeEndIp:        bizCluster.NodeEndIp,
-		Nodes:            nodes,
-		NodeGroups:       nodeGroups,
-		PublicKey:        bizCluster.PublicKey,
-		PrivateKey:       bizCluster.PrivateKey,
+		Id:                int32(bizCluster.Id),
+		Name:              bizCluster.Name,
+		ApiServerAddress:  bizCluster.ApiServerAddress,
+		ApiServerVip:      bizCluster.ApiServerVip,
+		Status:            bizCluster.Status.String(),
+		Level:             bizCluster.Level.String(),
+		Distribution:      bizCluster.Distribution.String(),
+		Cni:               bizCluster.Cni,
+		ExternallyManaged: bizCluster.ExternallyManaged,
+		Domain:            bizCluster.Domain,
+		NodeNumber:        int32(len(bizCluster.Nodes)),
+		Provider:          bizCluster.Provider.String(),
+		Region:            bizCluster.Region,
+		NodeUsername:      bizCluster.NodeUsername,
+		NodeStartIp:       bizCluster.NodeStartIp,
+		NodeEndIp:         bizCluster.NodeEndIp,
+		ProxyJump:         bizCluster.ProxyJump,
+		Nodes:             nodes,
+		NodeGroups:        nodeGroups,
+		PublicKey:         bizCluster.PublicKey,
 		ClusterResource: &v1alpha1.ClusterResource{
 			Cpu:    bizCluster.GetCpuCount(),
 			Gpu:    bizCluster.GetGpuCount(),
@@ -310,14 +531,28 @@ func (c *ClusterInterface) bizCLusterToCluster(bizCluster *biz.Cluster) *v1alpha
 
 func (c *ClusterInterface) bizNodeToNode(node *biz.Node) *v1alpha1.Node {
 	return &v1alpha1.Node{
-		Id:         int32(node.Id),
-		Ip:         node.Ip,
-		Name:       node.Name,
-		Role:       node.Role.String(),
-		User:       node.Username,
-		Status:     node.Status.String(),
-		InstanceId: node.InstanceId,
+		Id:                 int32(node.Id),
+		Ip:                 node.Ip,
+		Name:               node.Name,
+		Role:               node.Role.String(),
+		User:               node.Username,
+		Status:             node.Status.String(),
+		InstanceId:         node.InstanceId,
+		NodeGroupId:        node.NodeGroupId,
+		InstanceType:       node.InstanceType,
+		ErrorType:          node.ErrorType.String(),
+		ErrorMessage:       node.ErrorMessage,
+		ErrorStatus:        node.ErrorStatus.String(),
+		HostKeyFingerprint: utils.HostKeyFingerprint(node.HostKey),
+		PreflightStatus:    preflightStatusString(node.GetPreflightStatus()),
+	}
+}
+
+func preflightStatusString(status biz.PreflightStatus) string {
+	if status == biz.PreflightStatus_UNSPECIFIED {
+		return ""
 	}
+	return status.String()
 }
 
 func (c *ClusterInterface) bizNodeGroupToNodeGroup(nodeGroup *biz.NodeGroup) *v1alpha1.NodeGroup {
@@ -336,3 +571,597 @@ func (c *ClusterInterface) bizNodeGroupToNodeGroup(nodeGroup *biz.NodeGroup) *v1
 		TargetSize: nodeGroup.TargetSize,
 	}
 }
+
+func (c *ClusterInterface) bizEventToClusterEvent(event *biz.Event) *v1alpha1.ClusterEvent {
+	clusterEvent := &v1alpha1.ClusterEvent{
+		Id:         int32(event.Id),
+		Name:       event.Name,
+		Type:       event.Type.String(),
+		ParentId:   int32(event.ParentId),
+		ClusterId:  int32(event.SourceId),
+		Action:     event.Action.String(),
+		Status:     event.Status.String(),
+		Error:      event.Error,
+		Attempts:   event.Attempts,
+		StartedAt:  event.StartedAt,
+		FinishedAt: event.FinishedAt,
+		Duration:   event.Duration,
+		CreatedAt:  event.CreatedAt,
+		UpdatedAt:  event.UpdatedAt,
+	}
+	if progress := event.GetNodeProgress(); progress != nil {
+		clusterEvent.NodeProgress = &v1alpha1.NodeProgress{
+			Total:      int32(progress.Total),
+			Done:       int32(progress.Done),
+			Failed:     int32(progress.Failed),
+			Throughput: progress.Throughput,
+			Remaining:  progress.Remaining,
+		}
+	}
+	if event.Type == biz.EventType_WARNING {
+		clusterEvent.Message = event.Data
+	}
+	return clusterEvent
+}
+
+func (c *ClusterInterface) UpgradeCluster(ctx context.Context, args *v1alpha1.ClusterUpgradeArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	if args.Version == "" {
+		return nil, errors.New("version is required")
+	}
+	err := c.clusterUc.UpgradeCluster(ctx, int64(args.ClusterId), args.Version)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) GetEtcdBackupPolicy(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.EtcdBackupPolicy, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	policy, err := c.clusterUc.GetEtcdBackupPolicy(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return c.bizEtcdBackupPolicyToEtcdBackupPolicy(policy), nil
+}
+
+func (c *ClusterInterface) SaveEtcdBackupPolicy(ctx context.Context, args *v1alpha1.EtcdBackupPolicy) (*v1alpha1.EtcdBackupPolicy, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	policy := &biz.EtcdBackupPolicy{
+		ClusterId:   int64(args.ClusterId),
+		Enabled:     args.Enabled,
+		Schedule:    args.Schedule,
+		Retention:   args.Retention,
+		Storage:     biz.EtcdBackupStorageFromString(args.Storage),
+		S3Endpoint:  args.S3Endpoint,
+		S3Region:    args.S3Region,
+		S3Bucket:    args.S3Bucket,
+		S3AccessKey: args.S3AccessKey,
+		S3SecretKey: args.S3SecretKey,
+	}
+	err := c.clusterUc.SaveEtcdBackupPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizEtcdBackupPolicyToEtcdBackupPolicy(policy), nil
+}
+
+func (c *ClusterInterface) ListEtcdSnapshots(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.EtcdSnapshotList, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	snapshots, err := c.clusterUc.ListEtcdSnapshots(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.EtcdSnapshotList{Snapshots: make([]*v1alpha1.EtcdSnapshot, 0)}
+	for _, snapshot := range snapshots {
+		data.Snapshots = append(data.Snapshots, c.bizEtcdSnapshotToEtcdSnapshot(snapshot))
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) VerifyEtcdSnapshot(ctx context.Context, args *v1alpha1.EtcdSnapshotArgs) (*v1alpha1.EtcdSnapshot, error) {
+	if args.ClusterId == 0 || args.SnapshotId == 0 {
+		return nil, errors.New("cluster id and snapshot id are required")
+	}
+	snapshot, err := c.clusterUc.VerifyEtcdSnapshot(ctx, int64(args.ClusterId), args.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizEtcdSnapshotToEtcdSnapshot(snapshot), nil
+}
+
+func (c *ClusterInterface) RestoreEtcdSnapshot(ctx context.Context, args *v1alpha1.EtcdSnapshotArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.SnapshotId == 0 {
+		return nil, errors.New("cluster id and snapshot id are required")
+	}
+	err := c.clusterUc.RestoreEtcdSnapshot(ctx, int64(args.ClusterId), args.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+// the s3 secret key is write only
+func (c *ClusterInterface) bizEtcdBackupPolicyToEtcdBackupPolicy(policy *biz.EtcdBackupPolicy) *v1alpha1.EtcdBackupPolicy {
+	lastRunAt := ""
+	if policy.LastRunAt > 0 {
+		lastRunAt = time.Unix(policy.LastRunAt, 0).Format(time.DateTime)
+	}
+	return &v1alpha1.EtcdBackupPolicy{
+		ClusterId:   int32(policy.ClusterId),
+		Enabled:     policy.Enabled,
+		Schedule:    policy.Schedule,
+		Retention:   policy.Retention,
+		Storage:     policy.Storage.String(),
+		S3Endpoint:  policy.S3Endpoint,
+		S3Region:    policy.S3Region,
+		S3Bucket:    policy.S3Bucket,
+		S3AccessKey: policy.S3AccessKey,
+		LastRunAt:   lastRunAt,
+		UpdatedAt:   policy.UpdatedAt,
+	}
+}
+
+func (c *ClusterInterface) bizEtcdSnapshotToEtcdSnapshot(snapshot *biz.EtcdSnapshot) *v1alpha1.EtcdSnapshot {
+	return &v1alpha1.EtcdSnapshot{
+		Id:          snapshot.Id,
+		ClusterId:   int32(snapshot.ClusterId),
+		Name:        snapshot.Name,
+		Storage:     snapshot.Storage.String(),
+		Location:    snapshot.Location,
+		Size:        snapshot.Size,
+		Sha256:      snapshot.Sha256,
+		Revision:    snapshot.Revision,
+		TotalKeys:   snapshot.TotalKeys,
+		Status:      snapshot.Status.String(),
+		Error:       snapshot.Error,
+		VerifiedAt:  snapshot.VerifiedAt,
+		VerifyError: snapshot.VerifyError,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
+
+func (c *ClusterInterface) LoadKindImage(ctx context.Context, args *v1alpha1.ClusterLoadImageArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Image == "" {
+		return nil, errors.New("cluster id and image are required")
+	}
+	err := c.clusterUc.LoadKindImage(ctx, int64(args.ClusterId), args.Image)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) ImportCluster(ctx context.Context, args *v1alpha1.ClusterImportArgs) (*v1alpha1.Cluster, error) {
+	if args.Name == "" || args.Kubeconfig == "" {
+		return nil, errors.New("cluster name and kubeconfig are required")
+	}
+	provider := biz.ClusterProviderFromString(args.Provider)
+	if args.Provider != "" && provider == biz.ClusterProvider_UNSPECIFIED {
+		return nil, errors.New("cluster type is invalid")
+	}
+	cluster := &biz.Cluster{
+		Name:       args.Name,
+		Provider:   provider,
+		KubeConfig: args.Kubeconfig,
+		UserId:     biz.GetUserInfo(ctx).Id,
+	}
+	err := c.clusterUc.ImportCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizCLusterToCluster(cluster), nil
+}
+
+func (c *ClusterInterface) GetCloudDriftReport(ctx context.Context, args *v1alpha1.CloudDriftReportArgs) (*v1alpha1.CloudDriftReport, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	report, err := c.clusterUc.GetCloudDriftReport(ctx, int64(args.ClusterId), args.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizCloudDriftReportToCloudDriftReport(report), nil
+}
+
+func (c *ClusterInterface) RepairCloudDrift(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.CloudDriftReport, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	report, err := c.clusterUc.RepairCloudDrift(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return c.bizCloudDriftReportToCloudDriftReport(report), nil
+}
+
+func (c *ClusterInterface) bizCloudDriftReportToCloudDriftReport(report *biz.CloudDriftReport) *v1alpha1.CloudDriftReport {
+	data := &v1alpha1.CloudDriftReport{
+		ClusterId:  int32(report.ClusterId),
+		Status:     report.Status.String(),
+		Error:      report.Error,
+		CheckedAt:  report.CheckedAt,
+		RepairedAt: report.RepairedAt,
+		Drifts:     make([]*v1alpha1.CloudDrift, 0),
+	}
+	for _, drift := range report.Drifts {
+		data.Drifts = append(data.Drifts, &v1alpha1.CloudDrift{
+			ResourceId:   drift.ResourceId,
+			ResourceType: drift.ResourceType.String(),
+			RefId:        drift.RefId,
+			Name:         drift.Name,
+			Type:         drift.Type.String(),
+			Detail:       drift.Detail,
+		})
+	}
+	return data
+}
+
+func (c *ClusterInterface) CollectOrphanedCloudResources(ctx context.Context, args *v1alpha1.OrphanedCloudResourceArgs) (*v1alpha1.OrphanedCloudResources, error) {
+	if args.Provider == "" || args.AccessId == "" || args.AccessKey == "" {
+		return nil, errors.New("Provider, access id and access key are required")
+	}
+	result, err := c.gcUc.CollectOrphanedCloudResources(ctx, &biz.CloudResourceGCArgs{
+		Provider:    biz.ClusterProviderFromString(args.Provider),
+		AccessId:    args.AccessId,
+		AccessKey:   args.AccessKey,
+		Regions:     args.Regions,
+		Confirm:     args.Confirm,
+		ResourceIds: args.RefIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.OrphanedCloudResources{
+		Resources:   make([]*v1alpha1.OrphanedCloudResource, 0),
+		MonthlyCost: result.MonthlyCost,
+	}
+	for _, orphan := range result.Resources {
+		data.Resources = append(data.Resources, &v1alpha1.OrphanedCloudResource{
+			Region:       orphan.Region,
+			ClusterId:    int32(orphan.ClusterId),
+			ClusterName:  orphan.ClusterName,
+			ResourceType: orphan.Resource.Type.String(),
+			RefId:        orphan.Resource.RefId,
+			Name:         orphan.Resource.Name,
+			Reason:       orphan.Reason,
+			MonthlyCost:  orphan.MonthlyCost,
+			Deleted:      orphan.Deleted,
+			Error:        orphan.Error,
+		})
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) ListNodeGroups(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.NodeGroups, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	nodeGroups, err := c.clusterUc.ListNodeGroups(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.NodeGroups{NodeGroups: make([]*v1alpha1.NodeGroup, 0)}
+	for _, nodeGroup := range nodeGroups {
+		data.NodeGroups = append(data.NodeGroups, c.bizNodeGroupToNodeGroup(nodeGroup))
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) SaveNodeGroup(ctx context.Context, args *v1alpha1.NodeGroupArgs) (*v1alpha1.NodeGroup, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	nodeGroup := &biz.NodeGroup{
+		Id:         args.Id,
+		Name:       args.Name,
+		Type:       biz.NodeGroupTypeFromString(args.Type),
+		Os:         args.Os,
+		Arch:       biz.NodeArchTypeFromString(args.Arch),
+		Cpu:        args.Cpu,
+		Memory:     args.Memory,
+		Gpu:        args.Gpu,
+		GpuSpec:    biz.NodeGPUSpecFromString(args.GpuSpec),
+		MinSize:    args.MinSize,
+		MaxSize:    args.MaxSize,
+		TargetSize: args.TargetSize,
+	}
+	if args.Type != "" && nodeGroup.Type == biz.NodeGroupType_UNSPECIFIED {
+		return nil, errors.New("node group type is invalid")
+	}
+	if args.Arch != "" && nodeGroup.Arch == biz.NodeArchType_UNSPECIFIED {
+		return nil, errors.New("node group arch is invalid")
+	}
+	if args.GpuSpec != "" && nodeGroup.GpuSpec == biz.NodeGPUSpec_UNSPECIFIED {
+		return nil, errors.New("node group gpu spec is invalid")
+	}
+	nodeGroup, err := c.clusterUc.SaveNodeGroup(ctx, int64(args.ClusterId), nodeGroup)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizNodeGroupToNodeGroup(nodeGroup), nil
+}
+
+func (c *ClusterInterface) DeleteNodeGroup(ctx context.Context, args *v1alpha1.NodeGroupIdArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Id == "" {
+		return nil, errors.New("cluster id and node group id are required")
+	}
+	err := c.clusterUc.DeleteNodeGroup(ctx, int64(args.ClusterId), args.Id)
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) ListNodes(ctx context.Context, args *v1alpha1.NodeListArgs) (*v1alpha1.Nodes, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	filter := &biz.NodeFilter{
+		ClusterId:   int64(args.ClusterId),
+		NodeGroupId: args.NodeGroupId,
+		Status:      biz.NodeStatusFromString(args.Status),
+		Role:        biz.NodeRoleFromString(args.Role),
+	}
+	if args.Status != "" && filter.Status == biz.NodeStatus_UNSPECIFIED {
+		return nil, errors.New("node status is invalid")
+	}
+	if args.Role != "" && filter.Role == biz.NodeRole_UNSPECIFIED {
+		return nil, errors.New("node role is invalid")
+	}
+	nodes, err := c.clusterUc.ListNodes(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.Nodes{Nodes: make([]*v1alpha1.Node, 0)}
+	for _, node := range nodes {
+		data.Nodes = append(data.Nodes, c.bizNodeToNode(node))
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) GetNodeSystemInfo(ctx context.Context, args *v1alpha1.NodeIdArgs) (*v1alpha1.NodeSystemInfo, error) {
+	if args.ClusterId == 0 || args.Id == 0 {
+		return nil, errors.New("cluster id and node id are required")
+	}
+	systemInfo, err := c.clusterUc.GetNodeSystemInfo(ctx, int64(args.ClusterId), int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	data := &v1alpha1.NodeSystemInfo{
+		Os:      systemInfo.Os,
+		Arch:    systemInfo.Arch.String(),
+		Cpu:     systemInfo.Cpu,
+		Memory:  systemInfo.Memory,
+		Gpu:     systemInfo.Gpu,
+		GpuInfo: systemInfo.GpuInfo,
+		Disk:    systemInfo.Disk,
+		Disks:   make([]*v1alpha1.NodeDisk, 0),
+	}
+	for _, disk := range systemInfo.Disks {
+		data.Disks = append(data.Disks, &v1alpha1.NodeDisk{
+			Name:   disk.Name,
+			Device: disk.Device,
+			Size:   disk.Size,
+		})
+	}
+	return data, nil
+}
+
+func (c *ClusterInterface) RebootNode(ctx context.Context, args *v1alpha1.NodeIdArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Id == 0 {
+		return nil, errors.New("cluster id and node id are required")
+	}
+	err := c.clusterUc.RebootNode(ctx, int64(args.ClusterId), int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) ReinitNode(ctx context.Context, args *v1alpha1.NodeIdArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Id == 0 {
+		return nil, errors.New("cluster id and node id are required")
+	}
+	err := c.clusterUc.ReinitNode(ctx, int64(args.ClusterId), int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) ReplaceNode(ctx context.Context, args *v1alpha1.NodeIdArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Id == 0 {
+		return nil, errors.New("cluster id and node id are required")
+	}
+	err := c.clusterUc.ReplaceNode(ctx, int64(args.ClusterId), int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) AcceptNodeHostKey(ctx context.Context, args *v1alpha1.NodeHostKeyArgs) (*v1alpha1.Node, error) {
+	if args.ClusterId == 0 || args.Id == 0 || args.Fingerprint == "" {
+		return nil, errors.New("cluster id, node id and fingerprint are required")
+	}
+	node, err := c.clusterUc.AcceptNodeHostKey(ctx, int64(args.ClusterId), int64(args.Id), args.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizNodeToNode(node), nil
+}
+
+func (c *ClusterInterface) RunPreflight(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.PreflightReport, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	nodes, err := c.clusterUc.RunPreflight(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	report := &v1alpha1.PreflightReport{Nodes: make([]*v1alpha1.NodePreflight, 0)}
+	for _, node := range nodes {
+		nodePreflight := &v1alpha1.NodePreflight{
+			NodeId: int32(node.Id),
+			Name:   node.Name,
+			Ip:     node.Ip,
+			Role:   node.Role.String(),
+			Status: preflightStatusString(node.GetPreflightStatus()),
+			Checks: make([]*v1alpha1.PreflightCheck, 0),
+		}
+		for _, check := range node.GetPreflightChecks() {
+			nodePreflight.Checks = append(nodePreflight.Checks, &v1alpha1.PreflightCheck{
+				Name:    check.Name,
+				Status:  check.Status.String(),
+				Message: check.Message,
+			})
+		}
+		report.Nodes = append(report.Nodes, nodePreflight)
+	}
+	return report, nil
+}
+
+func (c *ClusterInterface) ListCertificates(ctx context.Context, args *v1alpha1.CertificateListArgs) (*v1alpha1.Certificates, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	certificates, err := c.clusterUc.ListCertificates(ctx, int64(args.ClusterId), args.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	res := &v1alpha1.Certificates{Certificates: make([]*v1alpha1.Certificate, 0)}
+	for _, certificate := range certificates {
+		daysRemaining, err := certificate.DaysRemaining(now)
+		if err != nil {
+			return nil, err
+		}
+		if certificate.CheckedAt > res.CheckedAt {
+			res.CheckedAt = certificate.CheckedAt
+		}
+		res.Certificates = append(res.Certificates, &v1alpha1.Certificate{
+			NodeId:        int32(certificate.NodeId),
+			NodeName:      certificate.NodeName,
+			Name:          certificate.Name,
+			Issuer:        certificate.Issuer,
+			ExpiresAt:     certificate.ExpiresAt,
+			DaysRemaining: int32(daysRemaining),
+		})
+	}
+	return res, nil
+}
+
+func (c *ClusterInterface) RotateCertificates(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*common.Msg, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	err := c.clusterUc.RotateCertificates(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
+
+func (c *ClusterInterface) GetInventory(ctx context.Context, args *v1alpha1.ClusterIdArgs) (*v1alpha1.Inventory, error) {
+	if args.Id == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	cluster, err := c.clusterUc.GetInventory(ctx, int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return c.bizClusterToInventory(cluster), nil
+}
+
+func (c *ClusterInterface) SaveInventory(ctx context.Context, args *v1alpha1.InventorySaveArgs) (*v1alpha1.Inventory, error) {
+	if args.ClusterId == 0 {
+		return nil, errors.New("cluster id is required")
+	}
+	if args.Content != "" && args.Format == "" {
+		return nil, errors.New("inventory format is required with the content")
+	}
+	saveArgs := &biz.InventorySaveArgs{
+		ClusterId:   int64(args.ClusterId),
+		Format:      args.Format,
+		Content:     args.Content,
+		Hosts:       make([]*biz.InventoryHost, 0),
+		Credentials: make([]*biz.NodeCredential, 0),
+	}
+	for _, host := range args.Hosts {
+		role := biz.NodeRoleFromString(host.Role)
+		if host.Role != "" && role == biz.NodeRole_UNSPECIFIED {
+			return nil, errors.Errorf("inventory host %s role is invalid", host.Host)
+		}
+		saveArgs.Hosts = append(saveArgs.Hosts, &biz.InventoryHost{
+			Host:       host.Host,
+			Port:       host.Port,
+			Username:   host.User,
+			Credential: host.Credential,
+			Role:       role,
+			NodeGroup:  host.NodeGroup,
+		})
+	}
+	for _, credential := range args.Credentials {
+		saveArgs.Credentials = append(saveArgs.Credentials, &biz.NodeCredential{
+			Name:       credential.Name,
+			PrivateKey: credential.PrivateKey,
+			Password:   credential.Password,
+		})
+	}
+	cluster, err := c.clusterUc.SaveInventory(ctx, saveArgs)
+	if err != nil {
+		return nil, err
+	}
+	return c.bizClusterToInventory(cluster), nil
+}
+
+// bizClusterToInventory returns the credential names only, the secrets are write only
+func (c *ClusterInterface) bizClusterToInventory(cluster *biz.Cluster) *v1alpha1.Inventory {
+	inventory := &v1alpha1.Inventory{
+		ClusterId:   int32(cluster.Id),
+		Hosts:       make([]*v1alpha1.InventoryHost, 0),
+		Credentials: make([]*v1alpha1.NodeCredential, 0),
+	}
+	for _, host := range cluster.InventoryHosts {
+		role := ""
+		if host.Role != biz.NodeRole_UNSPECIFIED {
+			role = host.Role.String()
+		}
+		inventory.Hosts = append(inventory.Hosts, &v1alpha1.InventoryHost{
+			Host:       host.Host,
+			Port:       host.Port,
+			User:       host.Username,
+			Credential: host.Credential,
+			Role:       role,
+			NodeGroup:  host.NodeGroup,
+		})
+	}
+	for _, credential := range cluster.NodeCredentials {
+		inventory.Credentials = append(inventory.Credentials, &v1alpha1.NodeCredential{
+			Name:          credential.Name,
+			HasPrivateKey: credential.PrivateKey != "",
+			HasPassword:   credential.Password != "",
+		})
+	}
+	return inventory
+}
+
+func (c *ClusterInterface) RetryNode(ctx context.Context, args *v1alpha1.NodeIdArgs) (*common.Msg, error) {
+	if args.ClusterId == 0 || args.Id == 0 {
+		return nil, errors.New("cluster id and node id are required")
+	}
+	err := c.clusterUc.RetryNode(ctx, int64(args.ClusterId), int64(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	return common.Response(), nil
+}
@@ -63,13 +63,13 @@ func (c *ClusterInterfaceMcpService) ClusterMcp() *server.MCPServer {
 			mcp.Description("public key required"),
 		), // Close WithString
 		mcp.WithString("private_key",
-			mcp.Description("private key required"),
+			mcp.Description("private key required, empty keeps the stored key on update"),
 		), // Close WithString
 		mcp.WithString("access_id",
 			mcp.Description("access id optional"),
 		), // Close WithString
 		mcp.WithString("access_key",
-			mcp.Description("access key optional"),
+			mcp.Description("access key optional, empty keeps the stored key on update"),
 		), // Close WithString
 		mcp.WithString("region",
 			mcp.Description("region optional"),
@@ -83,6 +83,21 @@ func (c *ClusterInterfaceMcpService) ClusterMcp() *server.MCPServer {
 		mcp.WithString("node_end_ip",
 			mcp.Description("node end ip optional"),
 		), // Close WithString
+		mcp.WithString("level",
+			mcp.Description("level optional, advanced and standard clusters run a highly available control plane 'basic' | 'standard' | 'advanced'"),
+		), // Close WithString
+		mcp.WithString("api_server_vip",
+			mcp.Description("api server virtual ip optional, required by a highly available baremetal cluster"),
+		), // Close WithString
+		mcp.WithString("distribution",
+			mcp.Description("kubernetes distribution optional, defaults to kubeadm 'kubeadm' | 'k3s'"),
+		), // Close WithString
+		mcp.WithString("proxy_jump",
+			mcp.Description("jump hosts the nodes are reached through optional, ssh ProxyJump syntax, eg: ops@bastion:2222,ops@10.0.0.5"),
+		), // Close WithString
+		mcp.WithString("jump_private_key",
+			mcp.Description("private key of the jump hosts optional, the cluster private key is used when empty, write only"),
+		), // Close WithString
 	) // Close NewTool
 	ser.AddTool(tool_Save, c.Save)
 
@@ -143,6 +158,481 @@ func (c *ClusterInterfaceMcpService) ClusterMcp() *server.MCPServer {
 	) // Close NewTool
 	ser.AddTool(tool_GetRegions, c.GetRegions)
 
+	// Add tool for ListEvents
+	tool_ListEvents := mcp.NewTool("ListEvents",
+		mcp.WithDescription("List cluster operation timeline events"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("parent_id",
+			mcp.Description("parent operation event id optional"),
+		), // Close WithNumber
+		mcp.WithString("type",
+			mcp.Description("event type optional 'operation' | 'step' | 'warning'"),
+		), // Close WithString
+		mcp.WithString("status",
+			mcp.Description("event status optional 'pending' | 'processing' | 'success' | 'failed' | 'dead_letter'"),
+		), // Close WithString
+		mcp.WithString("name",
+			mcp.Description("event name optional"),
+		), // Close WithString
+		mcp.WithNumber("page",
+			mcp.Description("page number, default is 1"),
+		), // Close WithNumber
+		mcp.WithNumber("page_size",
+			mcp.Description("page size, default is 10, max is 100"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_ListEvents, c.ListEvents)
+
+	// Add tool for GetProvisionSteps
+	tool_GetProvisionSteps := mcp.NewTool("GetProvisionSteps",
+		mcp.WithDescription("List cluster provisioning steps and their checkpoints"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_GetProvisionSteps, c.GetProvisionSteps)
+
+	// Add tool for RetryProvisionStep
+	tool_RetryProvisionStep := mcp.NewTool("RetryProvisionStep",
+		mcp.WithDescription("Retry a failed cluster provisioning step, provisioning resumes from it"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("step",
+			mcp.Description("step name required 'network' | 'key_pair' | 'system_info' | 'security_group' | 'instances' | 'slb' | 'preflight' | 'kubeadm_init' | 'joins' | 'runtime_install'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_RetryProvisionStep, c.RetryProvisionStep)
+
+	// Add tool for SkipProvisionStep
+	tool_SkipProvisionStep := mcp.NewTool("SkipProvisionStep",
+		mcp.WithDescription("Skip a failed cluster provisioning step, provisioning resumes after it"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("step",
+			mcp.Description("step name required 'network' | 'key_pair' | 'system_info' | 'security_group' | 'instances' | 'slb' | 'preflight' | 'kubeadm_init' | 'joins' | 'runtime_install'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_SkipProvisionStep, c.SkipProvisionStep)
+
+	// Add tool for Plan
+	tool_Plan := mcp.NewTool("Plan",
+		mcp.WithDescription("Plan previews the cloud resources, nodes and security rules a start or stop would create, update or delete, without calling the cloud provider"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("operation",
+			mcp.Description("operation required 'start' | 'stop'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_Plan, c.Plan)
+
+	// Add tool for UpgradeCluster
+	tool_UpgradeCluster := mcp.NewTool("UpgradeCluster",
+		mcp.WithDescription("UpgradeCluster upgrades kubernetes one minor version at a time, draining and upgrading one node after another"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("version",
+			mcp.Description("kubernetes version required e.g. 'v1.32.3'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_UpgradeCluster, c.UpgradeCluster)
+
+	// Add tool for GetEtcdBackupPolicy
+	tool_GetEtcdBackupPolicy := mcp.NewTool("GetEtcdBackupPolicy",
+		mcp.WithDescription("Get the etcd snapshot policy of a cluster"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_GetEtcdBackupPolicy, c.GetEtcdBackupPolicy)
+
+	// Add tool for SaveEtcdBackupPolicy
+	tool_SaveEtcdBackupPolicy := mcp.NewTool("SaveEtcdBackupPolicy",
+		mcp.WithDescription("Save the etcd snapshot policy of a cluster, snapshots are taken by the periodic cluster check"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithBoolean("enabled"), // Close WithBoolean
+		mcp.WithString("schedule",
+			mcp.Description("interval between snapshots, at least 10m e.g. '6h'"),
+		), // Close WithString
+		mcp.WithNumber("retention",
+			mcp.Description("snapshots kept, older snapshots are deleted"),
+		), // Close WithNumber
+		mcp.WithString("storage",
+			mcp.Description("local or s3"),
+		), // Close WithString
+		mcp.WithString("s3_endpoint",
+			mcp.Description("empty for aws s3, set for s3 compatible stores"),
+		), // Close WithString
+		mcp.WithString("s3_region"),     // Close WithString
+		mcp.WithString("s3_bucket"),     // Close WithString
+		mcp.WithString("s3_access_key"), // Close WithString
+		mcp.WithString("s3_secret_key",
+			mcp.Description("never returned, empty keeps the stored secret"),
+		), // Close WithString
+		mcp.WithString("last_run_at"), // Close WithString
+		mcp.WithString("updated_at"),  // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_SaveEtcdBackupPolicy, c.SaveEtcdBackupPolicy)
+
+	// Add tool for ListEtcdSnapshots
+	tool_ListEtcdSnapshots := mcp.NewTool("ListEtcdSnapshots",
+		mcp.WithDescription("List the etcd snapshots of a cluster, newest first"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_ListEtcdSnapshots, c.ListEtcdSnapshots)
+
+	// Add tool for VerifyEtcdSnapshot
+	tool_VerifyEtcdSnapshot := mcp.NewTool("VerifyEtcdSnapshot",
+		mcp.WithDescription("Verify the checksum of an etcd snapshot and that etcdutl can read it"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("snapshot_id",
+			mcp.Description("snapshot id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_VerifyEtcdSnapshot, c.VerifyEtcdSnapshot)
+
+	// Add tool for RestoreEtcdSnapshot
+	tool_RestoreEtcdSnapshot := mcp.NewTool("RestoreEtcdSnapshot",
+		mcp.WithDescription("Restore the control plane of a cluster from an etcd snapshot"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("snapshot_id",
+			mcp.Description("snapshot id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RestoreEtcdSnapshot, c.RestoreEtcdSnapshot)
+
+	// Add tool for LoadKindImage
+	tool_LoadKindImage := mcp.NewTool("LoadKindImage",
+		mcp.WithDescription("Load an image from the docker daemon of the cloud-copilot host into every node of a kind cluster"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("image",
+			mcp.Description("image in the docker daemon of the cloud-copilot host required e.g. 'cloud-copilot:0.0.1'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_LoadKindImage, c.LoadKindImage)
+
+	// Add tool for ImportCluster
+	tool_ImportCluster := mcp.NewTool("ImportCluster",
+		mcp.WithDescription("Import a running cluster from its kubeconfig and install the cloud-copilot operator on it"),
+		mcp.WithString("name",
+			mcp.Description("cluster name required"),
+		), // Close WithString
+		mcp.WithString("kubeconfig",
+			mcp.Description("kubeconfig content required, its current context is used"),
+		), // Close WithString
+		mcp.WithString("provider",
+			mcp.Description("cluster provider optional, only informational for imported clusters"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_ImportCluster, c.ImportCluster)
+
+	// Add tool for GetCloudDriftReport
+	tool_GetCloudDriftReport := mcp.NewTool("GetCloudDriftReport",
+		mcp.WithDescription("Get the cloud resource drift report of a cluster, refresh compares the stored resources with the cloud again"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithBoolean("refresh",
+			mcp.Description("compare with the cloud again instead of returning the last report"),
+		), // Close WithBoolean
+	) // Close NewTool
+	ser.AddTool(tool_GetCloudDriftReport, c.GetCloudDriftReport)
+
+	// Add tool for RepairCloudDrift
+	tool_RepairCloudDrift := mcp.NewTool("RepairCloudDrift",
+		mcp.WithDescription("Repair the drifted cloud resources of a cluster, missing resources are created again and security rules are reset"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RepairCloudDrift, c.RepairCloudDrift)
+
+	// Add tool for CollectOrphanedCloudResources
+	tool_CollectOrphanedCloudResources := mcp.NewTool("CollectOrphanedCloudResources",
+		mcp.WithDescription("Find cloud resources tagged by this cloud-copilot installation whose cluster is gone, confirm deletes the reviewed ones"),
+		mcp.WithString("provider",
+			mcp.Description("cluster provider required 'aws' | 'ali_cloud'"),
+		), // Close WithString
+		mcp.WithString("access_id",
+			mcp.Description("cloud access id required"),
+		), // Close WithString
+		mcp.WithString("access_key",
+			mcp.Description("cloud access key required"),
+		), // Close WithString
+		mcp.WithString("regions",
+			mcp.Description("regions to scan, every region of the account when empty"),
+		), // Close WithString
+		mcp.WithBoolean("confirm",
+			mcp.Description("delete the reviewed ref_ids that are still orphaned instead of only listing the orphaned resources"),
+		), // Close WithBoolean
+		mcp.WithString("ref_ids",
+			mcp.Description("ref ids of the listed orphaned resources the operator reviewed, required with confirm"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_CollectOrphanedCloudResources, c.CollectOrphanedCloudResources)
+
+	// Add tool for ListNodeGroups
+	tool_ListNodeGroups := mcp.NewTool("ListNodeGroups",
+		mcp.WithDescription("List the node groups of a cluster"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_ListNodeGroups, c.ListNodeGroups)
+
+	// Add tool for SaveNodeGroup
+	tool_SaveNodeGroup := mcp.NewTool("SaveNodeGroup",
+		mcp.WithDescription("Create or update a node group, a running cluster provisions or removes nodes to match the target size"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("id",
+			mcp.Description("node group id, empty creates a node group"),
+		), // Close WithString
+		mcp.WithString("name",
+			mcp.Description("node group name required"),
+		), // Close WithString
+		mcp.WithString("type",
+			mcp.Description("node group type required 'normal' | 'high_computation' | 'gpu_accelerated' | 'high_memory' | 'large_hard_disk' | 'load_disk'"),
+		), // Close WithString
+		mcp.WithString("os"), // Close WithString
+		mcp.WithString("arch",
+			mcp.Description("node arch required 'amd64' | 'arm64'"),
+		), // Close WithString
+		mcp.WithNumber("cpu",
+			mcp.Description("cpu cores required"),
+		), // Close WithNumber
+		mcp.WithNumber("memory",
+			mcp.Description("memory in GiB required"),
+		), // Close WithNumber
+		mcp.WithNumber("gpu"), // Close WithNumber
+		mcp.WithString("gpu_spec",
+			mcp.Description("'nvidia-a10' | 'nvidia-v100' | 'nvidia-t4' | 'nvidia-p100' | 'nvidia-p4'"),
+		), // Close WithString
+		mcp.WithNumber("min_size"), // Close WithNumber
+		mcp.WithNumber("max_size",
+			mcp.Description("max size required"),
+		), // Close WithNumber
+		mcp.WithNumber("target_size",
+			mcp.Description("between min size and max size"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_SaveNodeGroup, c.SaveNodeGroup)
+
+	// Add tool for DeleteNodeGroup
+	tool_DeleteNodeGroup := mcp.NewTool("DeleteNodeGroup",
+		mcp.WithDescription("Delete a node group and remove its nodes"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("id",
+			mcp.Description("node group id required"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_DeleteNodeGroup, c.DeleteNodeGroup)
+
+	// Add tool for ListNodes
+	tool_ListNodes := mcp.NewTool("ListNodes",
+		mcp.WithDescription("List the nodes of a cluster filtered by node group, status and role"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("node_group_id",
+			mcp.Description("node group id optional"),
+		), // Close WithString
+		mcp.WithString("status",
+			mcp.Description("node status optional"),
+		), // Close WithString
+		mcp.WithString("role",
+			mcp.Description("node role optional 'master' | 'worker' | 'edge'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_ListNodes, c.ListNodes)
+
+	// Add tool for GetNodeSystemInfo
+	tool_GetNodeSystemInfo := mcp.NewTool("GetNodeSystemInfo",
+		mcp.WithDescription("Get the cpu, memory, gpu and disks reported by the node"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_GetNodeSystemInfo, c.GetNodeSystemInfo)
+
+	// Add tool for RebootNode
+	tool_RebootNode := mcp.NewTool("RebootNode",
+		mcp.WithDescription("Drain, reboot and uncordon a node"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RebootNode, c.RebootNode)
+
+	// Add tool for ReinitNode
+	tool_ReinitNode := mcp.NewTool("ReinitNode",
+		mcp.WithDescription("Run the node initialization and component install on a node again"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_ReinitNode, c.ReinitNode)
+
+	// Add tool for ReplaceNode
+	tool_ReplaceNode := mcp.NewTool("ReplaceNode",
+		mcp.WithDescription("Replace a broken node with a new instance in the same node group"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_ReplaceNode, c.ReplaceNode)
+
+	// Add tool for RetryNode
+	tool_RetryNode := mcp.NewTool("RetryNode",
+		mcp.WithDescription("Run the failed phase of a node again"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RetryNode, c.RetryNode)
+
+	// Add tool for ExportClusterSpec
+	tool_ExportClusterSpec := mcp.NewTool("ExportClusterSpec",
+		mcp.WithDescription("ExportClusterSpec exports the cluster as a versioned spec file that can be kept in git and applied back"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("format",
+			mcp.Description("'yaml' | 'json', default 'yaml'"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_ExportClusterSpec, c.ExportClusterSpec)
+
+	// Add tool for ApplyClusterSpec
+	tool_ApplyClusterSpec := mcp.NewTool("ApplyClusterSpec",
+		mcp.WithDescription("ApplyClusterSpec creates the cluster of the spec or moves the existing one to it, applying the same spec twice changes nothing"),
+		mcp.WithString("spec",
+			mcp.Description("yaml or json cluster spec required"),
+		), // Close WithString
+		mcp.WithString("access_id",
+			mcp.Description("required to create a cloud cluster"),
+		), // Close WithString
+		mcp.WithString("access_key",
+			mcp.Description("required to create a cloud cluster"),
+		), // Close WithString
+		mcp.WithString("public_key",
+			mcp.Description("required to create a cluster"),
+		), // Close WithString
+		mcp.WithString("private_key",
+			mcp.Description("required to create a cluster"),
+		), // Close WithString
+		mcp.WithBoolean("dry_run",
+			mcp.Description("only return the plan"),
+		), // Close WithBoolean
+	) // Close NewTool
+	ser.AddTool(tool_ApplyClusterSpec, c.ApplyClusterSpec)
+
+	// Add tool for AcceptNodeHostKey
+	tool_AcceptNodeHostKey := mcp.NewTool("AcceptNodeHostKey",
+		mcp.WithDescription("Pin the ssh host key a node presents after a rebuild rotated it, the fingerprint verified out of band must match"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithNumber("id",
+			mcp.Description("node id required"),
+		), // Close WithNumber
+		mcp.WithString("fingerprint",
+			mcp.Description("sha256 fingerprint of the host key the node presents now required, eg: SHA256:xxx"),
+		), // Close WithString
+	) // Close NewTool
+	ser.AddTool(tool_AcceptNodeHostKey, c.AcceptNodeHostKey)
+
+	// Add tool for GetInventory
+	tool_GetInventory := mcp.NewTool("GetInventory",
+		mcp.WithDescription("Get the bare metal inventory, credential secrets are never returned"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_GetInventory, c.GetInventory)
+
+	// Add tool for SaveInventory
+	tool_SaveInventory := mcp.NewTool("SaveInventory",
+		mcp.WithDescription("Replace the bare metal inventory from hosts or a csv or ansible ini file, a running cluster joins the added hosts and removes the dropped workers"),
+		mcp.WithNumber("cluster_id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+		mcp.WithString("format",
+			mcp.Description("format of the content, csv or ini"),
+		), // Close WithString
+		mcp.WithString("content",
+			mcp.Description("csv with a header row of host,port,user,credential,role,node_group or an ansible ini inventory, replaces the hosts when set"),
+		), // Close WithString
+		mcp.WithObject("hosts",
+			mcp.Description("hosts, used when the content is empty"),
+		), // Close WithObject
+		mcp.WithObject("credentials",
+			mcp.Description("credentials upserted by name, credentials no host refers to are dropped"),
+		), // Close WithObject
+	) // Close NewTool
+	ser.AddTool(tool_SaveInventory, c.SaveInventory)
+
+	// Add tool for RunPreflight
+	tool_RunPreflight := mcp.NewTool("RunPreflight",
+		mcp.WithDescription("Run the preflight checks on the cluster nodes now, failed checks block the install"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RunPreflight, c.RunPreflight)
+
+	// Add tool for ListCertificates
+	tool_ListCertificates := mcp.NewTool("ListCertificates",
+		mcp.WithDescription("List the control plane certificates and the days they have left, refresh reads them from the masters first"),
+		mcp.WithNumber("cluster_id"), // Close WithNumber
+		mcp.WithBoolean("refresh",
+			mcp.Description("read the certificates from the masters instead of returning the last daily check"),
+		), // Close WithBoolean
+	) // Close NewTool
+	ser.AddTool(tool_ListCertificates, c.ListCertificates)
+
+	// Add tool for RotateCertificates
+	tool_RotateCertificates := mcp.NewTool("RotateCertificates",
+		mcp.WithDescription("Renew the control plane certificates and restart the static pods, one master at a time"),
+		mcp.WithNumber("id",
+			mcp.Description("cluster id required"),
+		), // Close WithNumber
+	) // Close NewTool
+	ser.AddTool(tool_RotateCertificates, c.RotateCertificates)
+
 	return ser
 }
 
@@ -345,3 +835,828 @@ func (c *ClusterInterfaceMcpService) GetRegions(ctx context.Context, request mcp
 	}
 	return mcp.NewToolResultText(string(resJson)), nil
 }
+
+func (c *ClusterInterfaceMcpService) ListEvents(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterEventListArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ListEvents(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) GetProvisionSteps(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.GetProvisionSteps(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RetryProvisionStep(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterProvisionStepArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RetryProvisionStep(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) SkipProvisionStep(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterProvisionStepArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.SkipProvisionStep(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) Plan(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterPlanArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.Plan(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) UpgradeCluster(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterUpgradeArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.UpgradeCluster(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) GetEtcdBackupPolicy(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.GetEtcdBackupPolicy(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) SaveEtcdBackupPolicy(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.EtcdBackupPolicy
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.SaveEtcdBackupPolicy(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ListEtcdSnapshots(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ListEtcdSnapshots(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) VerifyEtcdSnapshot(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.EtcdSnapshotArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.VerifyEtcdSnapshot(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RestoreEtcdSnapshot(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.EtcdSnapshotArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RestoreEtcdSnapshot(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) LoadKindImage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterLoadImageArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.LoadKindImage(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ImportCluster(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterImportArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ImportCluster(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) GetCloudDriftReport(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.CloudDriftReportArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.GetCloudDriftReport(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RepairCloudDrift(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RepairCloudDrift(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) CollectOrphanedCloudResources(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.OrphanedCloudResourceArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.CollectOrphanedCloudResources(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ListNodeGroups(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ListNodeGroups(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) SaveNodeGroup(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeGroupArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.SaveNodeGroup(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) DeleteNodeGroup(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeGroupIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.DeleteNodeGroup(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ListNodes(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeListArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ListNodes(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) GetNodeSystemInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.GetNodeSystemInfo(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RebootNode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RebootNode(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ReinitNode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ReinitNode(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ReplaceNode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ReplaceNode(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RetryNode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RetryNode(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ExportClusterSpec(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterSpecExportArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ExportClusterSpec(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ApplyClusterSpec(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterSpecApplyArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ApplyClusterSpec(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) AcceptNodeHostKey(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.NodeHostKeyArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.AcceptNodeHostKey(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) GetInventory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.GetInventory(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) SaveInventory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.InventorySaveArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.SaveInventory(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RunPreflight(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RunPreflight(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) ListCertificates(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.CertificateListArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.ListCertificates(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
+
+func (c *ClusterInterfaceMcpService) RotateCertificates(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := request.GetRawArguments()
+	jsonByte, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestArgs v1alpha1.ClusterIdArgs
+	err = json.Unmarshal(jsonByte, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.ClusterInterface.RotateCertificates(ctx, &requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resJson, err := json.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(resJson)), nil
+}
@@ -88,6 +88,7 @@ func (w *WorkspaceInterface) Delete(ctx context.Context, workspaceId *v1alpha1.W
 	return common.Response(), nil
 }
 
+// the repository tokens are write only
 func (w *WorkspaceInterface) bizToWorkspace(workspace *biz.Workspace) *v1alpha1.Workspace {
 	if workspace == nil {
 		return nil
@@ -128,7 +129,9 @@ func (w *WorkspaceInterface) workspaceToBiz(workspace *v1alpha1.Workspace) *biz.
 		Description:                   workspace.Description,
 		UserId:                        int64(workspace.UserId),
 		GitRepository:                 workspace.GitRepository,
+		GitRepositoryToken:            workspace.GitRepositoryToken,
 		ImageRepository:               workspace.ImageRepository,
+		ImageRepositoryToken:          workspace.ImageRepositoryToken,
 		ResourceQuota:                 resourceQuotaInterfaceToBiz(workspace.ResourceQuota),
 		WorkspaceClusterRelationships: clusterRelationship,
 	}
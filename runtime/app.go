@@ -31,12 +31,14 @@ func (a AppStatus) Int32() int32 {
 }
 
 type AppRuntime struct {
-	log *log.Helper
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewAppRuntime(logger log.Logger) biz.AppRuntime {
+func NewAppRuntime(clients *ClusterClients, logger log.Logger) biz.AppRuntime {
 	return &AppRuntime{
-		log: log.NewHelper(logger),
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -70,7 +72,11 @@ func (a *AppRuntime) ReloadAppRepo(ctx context.Context, appRepo *biz.AppRepo) er
 	obj := NewUnstructured(CloudAppKind)
 	obj.SetName(appRepo.Name)
 	SetSpec(obj, map[string]any{AppRepoObjName: appRepo})
-	obj, err := GetObjResrouce(ctx, obj, AppStatus_COMPLETED.Int32(), AppStatus_FAILED.Int32())
+	dynamicClient, err := a.clients.DynamicClient(ctx, 0)
+	if err != nil {
+		return err
+	}
+	obj, err = GetObjResrouce(ctx, dynamicClient, obj, AppStatus_COMPLETED.Int32(), AppStatus_FAILED.Int32())
 	if err != nil {
 		return err
 	}
@@ -88,7 +94,11 @@ func (a *AppRuntime) ReloadAppRepo(ctx context.Context, appRepo *biz.AppRepo) er
 func (a *AppRuntime) GetAppAndVersionInfo(ctx context.Context, app *biz.App) error {
 	obj := NewUnstructuredWithGenerateName(CloudAppKind, app.Name)
 	SetSpec(obj, map[string]any{AppObjName: app})
-	obj, err := GetObjResrouce(ctx, obj, AppStatus_COMPLETED.Int32(), AppStatus_FAILED.Int32())
+	dynamicClient, err := a.clients.DynamicClient(ctx, 0)
+	if err != nil {
+		return err
+	}
+	obj, err = GetObjResrouce(ctx, dynamicClient, obj, AppStatus_COMPLETED.Int32(), AppStatus_FAILED.Int32())
 	if err != nil {
 		return err
 	}
@@ -109,7 +119,7 @@ func (a *AppRuntime) AppRelease(ctx context.Context, appRelease *biz.AppRelease)
 	obj.SetName(appRelease.ReleaseName)
 	obj.SetNamespace(appRelease.Namespace)
 	SetSpec(obj, appRelease)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := a.clients.DynamicClient(ctx, appRelease.ClusterId)
 	if err != nil {
 		return err
 	}
@@ -124,7 +134,7 @@ func (a *AppRuntime) GetAppReleaseResources(ctx context.Context, appRelease *biz
 	obj := NewUnstructured(CloudAppReleaseKind)
 	obj.SetName(appRelease.ReleaseName)
 	obj.SetNamespace(appRelease.Namespace)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := a.clients.DynamicClient(ctx, appRelease.ClusterId)
 	if err != nil {
 		return err
 	}
@@ -143,7 +153,7 @@ func (a *AppRuntime) DeleteAppRelease(ctx context.Context, appRelease *biz.AppRe
 	obj := NewUnstructured(CloudAppReleaseKind)
 	obj.SetName(appRelease.ReleaseName)
 	obj.SetNamespace(appRelease.Namespace)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := a.clients.DynamicClient(ctx, appRelease.ClusterId)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,224 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/f-rambo/cloud-copilot/internal/biz"
+	"github.com/f-rambo/cloud-copilot/utils"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	clusterClientCheckInterval = 30 * time.Second
+	clusterClientTimeout       = 30 * time.Second
+)
+
+type clusterClient struct {
+	kubeConfigHash string
+	checkedAt      time.Time
+	kubeClient     *kubernetes.Clientset
+	dynamicClient  *dynamic.DynamicClient
+}
+
+// ClusterClients caches the kubernetes clients of the managed clusters by cluster id,
+// only a request that names no cluster is reached through the default kubeconfig
+type ClusterClients struct {
+	clusterData biz.ClusterData
+	clients     map[int64]*clusterClient
+	// one health check or rebuild per cluster at a time, an unreachable cluster does not block the others
+	clusterLocks map[int64]*sync.Mutex
+	// guards clients and clusterLocks, never held during api server calls
+	mux sync.Mutex
+	log *log.Helper
+}
+
+func NewClusterClients(clusterData biz.ClusterData, logger log.Logger) *ClusterClients {
+	return &ClusterClients{
+		clusterData:  clusterData,
+		clients:      make(map[int64]*clusterClient),
+		clusterLocks: make(map[int64]*sync.Mutex),
+		log:          log.NewHelper(logger),
+	}
+}
+
+// GetClusterById resolves the cluster named by the request, the cluster in the context is used when the id is empty
+func (c *ClusterClients) GetClusterById(ctx context.Context, clusterId int64) (*biz.Cluster, error) {
+	cluster := biz.GetCluster(ctx)
+	if clusterId == 0 || (cluster != nil && cluster.Id == clusterId) {
+		return cluster, nil
+	}
+	cluster, err := c.clusterData.Get(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.Errorf("cluster %d not found", clusterId)
+	}
+	return cluster, nil
+}
+
+func (c *ClusterClients) KubeClient(ctx context.Context, clusterId int64) (*kubernetes.Clientset, error) {
+	cluster, err := c.GetClusterById(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClusterClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return client.kubeClient, nil
+}
+
+func (c *ClusterClients) DynamicClient(ctx context.Context, clusterId int64) (*dynamic.DynamicClient, error) {
+	cluster, err := c.GetClusterById(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	return c.ClusterDynamicClient(ctx, cluster)
+}
+
+func (c *ClusterClients) ClusterKubeClient(ctx context.Context, cluster *biz.Cluster) (*kubernetes.Clientset, error) {
+	client, err := c.getClusterClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return client.kubeClient, nil
+}
+
+func (c *ClusterClients) ClusterDynamicClient(ctx context.Context, cluster *biz.Cluster) (*dynamic.DynamicClient, error) {
+	client, err := c.getClusterClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return client.dynamicClient, nil
+}
+
+// Invalidate drops the cached clients, the next call builds them from the stored kubeconfig again
+func (c *ClusterClients) Invalidate(clusterId int64) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	delete(c.clients, clusterId)
+}
+
+// getClusterClient returns the cached clients while the kubeconfig is unchanged and the api server answers,
+// clients that fail the health check are rebuilt once before the error is returned
+func (c *ClusterClients) getClusterClient(ctx context.Context, cluster *biz.Cluster) (*clusterClient, error) {
+	if cluster == nil {
+		return newDefaultClusterClient()
+	}
+	if cluster.KubeConfig == "" {
+		return nil, errors.Errorf("cluster %s has no kubeconfig", cluster.Name)
+	}
+	// a cluster being imported has no id yet, it is not cached
+	if cluster.Id == 0 {
+		return newClusterClient(cluster.KubeConfig)
+	}
+	clusterLock := c.getClusterLock(cluster.Id)
+	clusterLock.Lock()
+	defer clusterLock.Unlock()
+	kubeConfigHash := utils.Md5(cluster.KubeConfig)
+	client, ok := c.getCachedClient(cluster.Id)
+	if ok && client.kubeConfigHash == kubeConfigHash {
+		if time.Since(client.checkedAt) < clusterClientCheckInterval {
+			return client, nil
+		}
+		err := client.healthCheck()
+		if err == nil {
+			return client, nil
+		}
+		c.log.Warnf("cluster %s client health check failed, rebuild it: %v", cluster.Name, err)
+	}
+	c.setCachedClient(cluster.Id, nil)
+	client, err := newClusterClient(cluster.KubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = client.healthCheck()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cluster %s api server is unreachable", cluster.Name)
+	}
+	c.setCachedClient(cluster.Id, client)
+	return client, nil
+}
+
+func (c *ClusterClients) getClusterLock(clusterId int64) *sync.Mutex {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	clusterLock, ok := c.clusterLocks[clusterId]
+	if !ok {
+		clusterLock = new(sync.Mutex)
+		c.clusterLocks[clusterId] = clusterLock
+	}
+	return clusterLock
+}
+
+func (c *ClusterClients) getCachedClient(clusterId int64) (*clusterClient, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	client, ok := c.clients[clusterId]
+	return client, ok
+}
+
+// setCachedClient swaps the cached clients of the cluster, nil drops them
+func (c *ClusterClients) setCachedClient(clusterId int64, client *clusterClient) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if client == nil {
+		delete(c.clients, clusterId)
+		return
+	}
+	c.clients[clusterId] = client
+}
+
+func (c *clusterClient) healthCheck() error {
+	_, err := c.kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return err
+	}
+	c.checkedAt = time.Now()
+	return nil
+}
+
+func newClusterClient(kubeConfig string) (*clusterClient, error) {
+	config, err := GetRestConfigByKubeConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.Timeout = clusterClientTimeout
+	client, err := newClusterClientByConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	client.kubeConfigHash = utils.Md5(kubeConfig)
+	return client, nil
+}
+
+func newDefaultClusterClient() (*clusterClient, error) {
+	kubeClient, err := GetKubeClient()
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := GetKubeDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	return &clusterClient{kubeClient: kubeClient, dynamicClient: dynamicClient}, nil
+}
+
+func newClusterClientByConfig(config *rest.Config) (*clusterClient, error) {
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "get kubernetes client failed")
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "get kubernetes dynamic client failed")
+	}
+	return &clusterClient{kubeClient: kubeClient, dynamicClient: dynamicClient}, nil
+}
@@ -2,29 +2,50 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/f-rambo/cloud-copilot/internal/biz"
 	"github.com/f-rambo/cloud-copilot/internal/conf"
 	"github.com/f-rambo/cloud-copilot/utils"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	k8sErr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
 	CloudClusterKind = "CloudCluster"
+
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
 )
 
+var gatewayClassResource = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gatewayclasses"}
+
 type ClusterRuntime struct {
-	conf *conf.Bootstrap
-	log  *log.Helper
+	conf    *conf.Bootstrap
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewClusterRuntime(conf *conf.Bootstrap, logger log.Logger) biz.ClusterRuntime {
+func NewClusterRuntime(conf *conf.Bootstrap, clients *ClusterClients, logger log.Logger) biz.ClusterRuntime {
 	return &ClusterRuntime{
-		conf: conf,
-		log:  log.NewHelper(logger),
+		conf:    conf,
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -32,7 +53,7 @@ func (c *ClusterRuntime) ReloadCluster(ctx context.Context, cluster *biz.Cluster
 	obj := NewUnstructured(CloudClusterKind)
 	obj.SetName(cluster.Name)
 	SetSpec(obj, cluster)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := c.clients.ClusterDynamicClient(ctx, cluster)
 	if err != nil {
 		return err
 	}
@@ -58,7 +79,7 @@ func (c *ClusterRuntime) CurrentCluster(ctx context.Context, cluster *biz.Cluste
 	if cluster.Name == "" {
 		return errors.New("cluster name is empty")
 	}
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := c.clients.ClusterDynamicClient(ctx, cluster)
 	if err != nil {
 		return err
 	}
@@ -83,18 +104,268 @@ func (c *ClusterRuntime) Install(ctx context.Context, cluster *biz.Cluster) erro
 	if err != nil {
 		return err
 	}
-	err = CreateResourceByYaml(ctx, installYaml)
+	dynamicClient, err := c.clients.ClusterDynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	err = CreateResourceByYamlWithClient(ctx, dynamicClient, installYaml)
 	if err != nil {
 		return err
 	}
 	return c.ReloadCluster(ctx, cluster)
 }
 
+func (c *ClusterRuntime) InvalidateClusterClient(clusterId int64) {
+	c.clients.Invalidate(clusterId)
+}
+
 func (c *ClusterRuntime) ClusterIsExist(ctx context.Context) bool {
-	err := CheckKubernetesConnection(ctx)
+	dynamicClient, err := c.clients.DynamicClient(ctx, 0)
+	if err != nil {
+		c.log.Errorf("get kubernetes client failed: %v", err)
+		return false
+	}
+	err = CheckKubernetesConnection(ctx, dynamicClient)
 	if err != nil {
 		c.log.Errorf("check kubernetes connection failed: %v", err)
 		return false
 	}
 	return true
 }
+
+// DrainNode cordons the node and evicts its pods through the eviction api so PodDisruptionBudgets are honoured,
+// daemonset and mirror pods are left in place
+func (c *ClusterRuntime) DrainNode(ctx context.Context, node *biz.Node, timeout time.Duration) error {
+	client, err := c.clients.KubeClient(ctx, node.ClusterId)
+	if err != nil {
+		return err
+	}
+	err = setNodeUnschedulable(ctx, client, node.Name, true)
+	if k8sErr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return wait.PollUntilContextTimeout(ctx, time.Second*5, timeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node.Name).String(),
+		})
+		if err != nil {
+			return false, err
+		}
+		remaining := 0
+		for _, pod := range pods.Items {
+			if !isEvictablePod(pod) {
+				continue
+			}
+			remaining++
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			err = client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			})
+			if err == nil || k8sErr.IsNotFound(err) {
+				continue
+			}
+			// blocked by a PodDisruptionBudget, try again on the next round
+			if k8sErr.IsTooManyRequests(err) {
+				c.log.Warnf("evict pod %s/%s on node %s blocked: %v", pod.Namespace, pod.Name, node.Name, err)
+				continue
+			}
+			return false, errors.Wrapf(err, "evict pod %s/%s", pod.Namespace, pod.Name)
+		}
+		return remaining == 0, nil
+	})
+}
+
+func (c *ClusterRuntime) UncordonNode(ctx context.Context, node *biz.Node) error {
+	client, err := c.clients.KubeClient(ctx, node.ClusterId)
+	if err != nil {
+		return err
+	}
+	return setNodeUnschedulable(ctx, client, node.Name, false)
+}
+
+// DeleteNode removes the node object from the cluster, a node that never registered is not an error
+func (c *ClusterRuntime) DeleteNode(ctx context.Context, node *biz.Node) error {
+	client, err := c.clients.KubeClient(ctx, node.ClusterId)
+	if err != nil {
+		return err
+	}
+	err = client.CoreV1().Nodes().Delete(ctx, node.Name, metav1.DeleteOptions{})
+	if err != nil && !k8sErr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// WaitNodeReady waits for the node to report Ready, and for its kubelet to run the given version when one is set
+func (c *ClusterRuntime) WaitNodeReady(ctx context.Context, node *biz.Node, version string, timeout time.Duration) error {
+	client, err := c.clients.KubeClient(ctx, node.ClusterId)
+	if err != nil {
+		return err
+	}
+	err = wait.PollUntilContextTimeout(ctx, time.Second*5, timeout, true, func(ctx context.Context) (bool, error) {
+		k8sNode, err := client.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		if version != "" && k8sNode.Status.NodeInfo.KubeletVersion != version {
+			return false, nil
+		}
+		for _, condition := range k8sNode.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				return condition.Status == corev1.ConditionTrue, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "node %s is not ready", node.Name)
+	}
+	return nil
+}
+
+// IsNodeReady reports whether the node object exists and its Ready condition is true
+func (c *ClusterRuntime) IsNodeReady(ctx context.Context, node *biz.Node) (bool, error) {
+	client, err := c.clients.KubeClient(ctx, node.ClusterId)
+	if err != nil {
+		return false, err
+	}
+	k8sNode, err := client.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	if k8sErr.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, condition := range k8sNode.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
+
+func setNodeUnschedulable(ctx context.Context, client *kubernetes.Clientset, nodeName string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := client.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+func isEvictablePod(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+var knownCnis = []string{"cilium", "calico", "flannel", "weave", "kindnet", "antrea", "kube-router", "canal"}
+
+// DiscoverCluster reads the nodes, version, cni, default storage class and gateway class of an imported cluster
+func (c *ClusterRuntime) DiscoverCluster(ctx context.Context, cluster *biz.Cluster) error {
+	config, err := GetRestConfigByKubeConfig(cluster.KubeConfig)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "get kubernetes by kubeconfig client failed")
+	}
+	serverVersion, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to the cluster")
+	}
+	cluster.SetKubernetesVersion(serverVersion.GitVersion)
+	if apiServerUrl, err := url.Parse(config.Host); err == nil {
+		cluster.ApiServerAddress = apiServerUrl.Hostname()
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes.Items {
+		cluster.AddNode(kubeNodeToNode(cluster, &node))
+	}
+	daemonSets, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, daemonSet := range daemonSets.Items {
+		if cni := slices.IndexFunc(knownCnis, func(cni string) bool { return strings.Contains(daemonSet.Name, cni) }); cni >= 0 {
+			cluster.Cni = knownCnis[cni]
+			break
+		}
+	}
+	storageClasses, err := client.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, storageClass := range storageClasses.Items {
+		if cluster.StorageClass == "" || storageClass.Annotations[defaultStorageClassAnnotation] == "true" {
+			cluster.StorageClass = storageClass.Name
+		}
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	// the gateway api is optional, clusters without its crds keep an empty gateway class
+	gatewayClasses, err := dynamicClient.Resource(gatewayClassResource).List(ctx, metav1.ListOptions{})
+	if err == nil && len(gatewayClasses.Items) > 0 {
+		cluster.GatewayClass = gatewayClasses.Items[0].GetName()
+	}
+	return nil
+}
+
+func kubeNodeToNode(cluster *biz.Cluster, kubeNode *corev1.Node) *biz.Node {
+	node := &biz.Node{
+		Name:      kubeNode.Name,
+		Role:      biz.NodeRole_WORKER,
+		Status:    biz.NodeStatus_NODE_ERROR,
+		ClusterId: cluster.Id,
+	}
+	for _, address := range kubeNode.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			node.Ip = address.Address
+			break
+		}
+	}
+	for _, roleLabel := range []string{"node-role.kubernetes.io/control-plane", "node-role.kubernetes.io/master"} {
+		if _, ok := kubeNode.Labels[roleLabel]; ok {
+			node.Role = biz.NodeRole_MASTER
+		}
+	}
+	for _, condition := range kubeNode.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+			node.Status = biz.NodeStatus_NODE_RUNNING
+		}
+	}
+	if node.Status == biz.NodeStatus_NODE_ERROR {
+		node.ErrorType = biz.NodeErrorType_CLUSTER_ERROR
+		node.ErrorMessage = "node is not ready"
+	}
+	labels, _ := json.Marshal(kubeNode.Labels)
+	node.Labels = string(labels)
+	nodeInfo, _ := json.Marshal(map[string]string{
+		"os":                kubeNode.Status.NodeInfo.OSImage,
+		"arch":              kubeNode.Status.NodeInfo.Architecture,
+		"kernel":            kubeNode.Status.NodeInfo.KernelVersion,
+		"container_runtime": kubeNode.Status.NodeInfo.ContainerRuntimeVersion,
+		"kubelet":           kubeNode.Status.NodeInfo.KubeletVersion,
+		"cpu":               kubeNode.Status.Capacity.Cpu().String(),
+		"memory":            kubeNode.Status.Capacity.Memory().String(),
+	})
+	node.NodeInfo = string(nodeInfo)
+	return node
+}
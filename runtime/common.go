@@ -124,11 +124,15 @@ func WaitForCRDStatus(ctx context.Context, dynamicClient *dynamic.DynamicClient,
 }
 
 func CreateResourceByYaml(ctx context.Context, yamlFilePath string) error {
-	objs, err := ParseYaml(yamlFilePath)
+	dynamicClient, err := GetKubeDynamicClient()
 	if err != nil {
 		return err
 	}
-	dynamicClient, err := GetKubeDynamicClient()
+	return CreateResourceByYamlWithClient(ctx, dynamicClient, yamlFilePath)
+}
+
+func CreateResourceByYamlWithClient(ctx context.Context, dynamicClient dynamic.Interface, yamlFilePath string) error {
+	objs, err := ParseYaml(yamlFilePath)
 	if err != nil {
 		return err
 	}
@@ -187,6 +191,15 @@ func GetKubeDynamicClient(KubeConfigPaths ...string) (*dynamic.DynamicClient, er
 	return client, nil
 }
 
+// GetRestConfigByKubeConfig builds the client config from kubeconfig content instead of a file
+func GetRestConfigByKubeConfig(kubeConfig string) (*rest.Config, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid kubeconfig")
+	}
+	return config, nil
+}
+
 func GetKubeClient(KubeConfigPaths ...string) (clientset *kubernetes.Clientset, err error) {
 	var KubeConfigPath string
 	if len(KubeConfigPaths) == 0 {
@@ -288,12 +301,8 @@ func WaitForPodReady(ctx context.Context, client *kubernetes.Clientset, namespac
 	})
 }
 
-func GetObjResrouce(ctx context.Context, obj *unstructured.Unstructured, successStatus, failedStatus int32) (*unstructured.Unstructured, error) {
-	dynamicClient, err := GetKubeDynamicClient()
-	if err != nil {
-		return nil, err
-	}
-	err = CreateResource(ctx, dynamicClient, obj)
+func GetObjResrouce(ctx context.Context, dynamicClient *dynamic.DynamicClient, obj *unstructured.Unstructured, successStatus, failedStatus int32) (*unstructured.Unstructured, error) {
+	err := CreateResource(ctx, dynamicClient, obj)
 	if err != nil {
 		return nil, err
 	}
@@ -464,12 +473,8 @@ func formatUnstructuredField(builder *strings.Builder, field any, indent int) {
 	}
 }
 
-func CheckKubernetesConnection(ctx context.Context) error {
-	client, err := GetKubeDynamicClient()
-	if err != nil {
-		return errors.Wrap(err, "get kubernetes by kubeconfig client failed")
-	}
-	_, err = client.Resource(schema.GroupVersionResource{
+func CheckKubernetesConnection(ctx context.Context, client dynamic.Interface) error {
+	_, err := client.Resource(schema.GroupVersionResource{
 		Group:    "",
 		Version:  "v1",
 		Resource: "namespaces",
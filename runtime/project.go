@@ -13,12 +13,14 @@ const (
 )
 
 type ProjectRuntime struct {
-	log *log.Helper
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewProjectRuntime(logger log.Logger) biz.ProjectRuntime {
+func NewProjectRuntime(clients *ClusterClients, logger log.Logger) biz.ProjectRuntime {
 	return &ProjectRuntime{
-		log: log.NewHelper(logger),
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -28,7 +30,7 @@ func (uc *ProjectRuntime) Reload(ctx context.Context, project *biz.Project) erro
 	obj.SetName(project.Name)
 	obj.SetNamespace(workspace.Name)
 	SetSpec(obj, project)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := uc.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
@@ -57,7 +59,7 @@ func (uc *ProjectRuntime) Delete(ctx context.Context, project *biz.Project) erro
 	obj.SetName(project.Name)
 	obj.SetNamespace(workspace.Name)
 	SetSpec(obj, project)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := uc.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
@@ -2,4 +2,4 @@ package runtime
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewAppRuntime, NewClusterRuntime, NewProjectRuntime, NewServiceRuntime, NewWorkflowRuntime, NewWorkspaceRuntime)
+var ProviderSet = wire.NewSet(NewClusterClients, NewAppRuntime, NewClusterRuntime, NewProjectRuntime, NewServiceRuntime, NewWorkflowRuntime, NewWorkspaceRuntime)
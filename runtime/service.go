@@ -14,12 +14,14 @@ const (
 )
 
 type ServiceRuntime struct {
-	log *log.Helper
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewServiceRuntime(logger log.Logger) biz.ServiceRuntime {
+func NewServiceRuntime(clients *ClusterClients, logger log.Logger) biz.ServiceRuntime {
 	return &ServiceRuntime{
-		log: log.NewHelper(logger),
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -79,8 +81,12 @@ type NetworkPolicy struct {
 }
 
 func (s *ServiceRuntime) ApplyService(ctx context.Context, service *biz.Service, continuousDeployment *biz.ContinuousDeployment) error {
-	err := CheckKubernetesConnection(ctx)
+	dynamicClient, err := s.clients.DynamicClient(ctx, service.ClusterId)
+	if err == nil {
+		err = CheckKubernetesConnection(ctx, dynamicClient)
+	}
 	if err != nil {
+		s.log.Warnf("cluster of service %s is not connected: %v", service.Name, err)
 		return nil
 	}
 	resourceQuota := service.ResourceQuota.ToResourceQuota()
@@ -139,10 +145,6 @@ func (s *ServiceRuntime) ApplyService(ctx context.Context, service *biz.Service,
 	obj.SetName(service.Name)
 	obj.SetNamespace(service.GetWorkspaceNameByLable())
 	SetSpec(obj, cloudServiceSpec)
-	dynamicClient, err := GetKubeDynamicClient()
-	if err != nil {
-		return err
-	}
 	_, err = GetResource(ctx, dynamicClient, obj)
 	if err != nil {
 		if k8sErr.IsNotFound(err) {
@@ -163,17 +165,17 @@ func (s *ServiceRuntime) ApplyService(ctx context.Context, service *biz.Service,
 }
 
 func (s *ServiceRuntime) GetServiceStatus(ctx context.Context, service *biz.Service) error {
-	err := CheckKubernetesConnection(ctx)
+	dynamicClient, err := s.clients.DynamicClient(ctx, service.ClusterId)
+	if err == nil {
+		err = CheckKubernetesConnection(ctx, dynamicClient)
+	}
 	if err != nil {
+		s.log.Warnf("cluster of service %s is not connected: %v", service.Name, err)
 		return nil
 	}
 	obj := NewUnstructured(CloudServiceKind)
 	obj.SetName(service.Name)
 	obj.SetNamespace(service.GetWorkspaceNameByLable())
-	dynamicClient, err := GetKubeDynamicClient()
-	if err != nil {
-		return err
-	}
 	obj, err = GetResource(ctx, dynamicClient, obj)
 	if err != nil {
 		return err
@@ -191,17 +193,17 @@ func (s *ServiceRuntime) GetServiceStatus(ctx context.Context, service *biz.Serv
 
 // DeleteService(ctx context.Context, service *Service) error
 func (s *ServiceRuntime) DeleteService(ctx context.Context, service *biz.Service) error {
-	err := CheckKubernetesConnection(ctx)
+	dynamicClient, err := s.clients.DynamicClient(ctx, service.ClusterId)
+	if err == nil {
+		err = CheckKubernetesConnection(ctx, dynamicClient)
+	}
 	if err != nil {
+		s.log.Warnf("cluster of service %s is not connected: %v", service.Name, err)
 		return nil
 	}
 	obj := NewUnstructured(CloudServiceKind)
 	obj.SetName(service.Name)
 	obj.SetNamespace(service.GetWorkspaceNameByLable())
-	dynamicClient, err := GetKubeDynamicClient()
-	if err != nil {
-		return err
-	}
 	err = DeleteResource(ctx, dynamicClient, obj)
 	if err != nil {
 		return err
@@ -13,12 +13,14 @@ const (
 )
 
 type WorkflowRuntime struct {
-	log *log.Helper
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewWorkflowRuntime(logger log.Logger) biz.WorkflowRuntime {
+func NewWorkflowRuntime(clients *ClusterClients, logger log.Logger) biz.WorkflowRuntime {
 	return &WorkflowRuntime{
-		log: log.NewHelper(logger),
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -27,7 +29,7 @@ func (w *WorkflowRuntime) CommitWorkflow(ctx context.Context, workflow *biz.Work
 	obj.SetNamespace(workflow.Namespace)
 	obj.SetLabels(biz.LablesToMap(workflow.Lables))
 	SetSpec(obj, workflow)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := w.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
@@ -43,7 +45,7 @@ func (w *WorkflowRuntime) GetWorkflowStatus(ctx context.Context, workflow *biz.W
 	obj := NewUnstructured(CloudWorkflowKind)
 	obj.SetName(workflow.Name)
 	obj.SetNamespace(workflow.Namespace)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := w.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
@@ -62,7 +64,7 @@ func (w *WorkflowRuntime) CleanWorkflow(ctx context.Context, workflow *biz.Workf
 	obj := NewUnstructured(CloudWorkflowKind)
 	obj.SetName(workflow.Name)
 	obj.SetNamespace(workflow.Namespace)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := w.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
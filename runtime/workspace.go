@@ -13,12 +13,14 @@ const (
 )
 
 type WorkspaceRuntime struct {
-	log *log.Helper
+	clients *ClusterClients
+	log     *log.Helper
 }
 
-func NewWorkspaceRuntime(logger log.Logger) biz.WorkspaceRuntime {
+func NewWorkspaceRuntime(clients *ClusterClients, logger log.Logger) biz.WorkspaceRuntime {
 	return &WorkspaceRuntime{
-		log: log.NewHelper(logger),
+		clients: clients,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -27,7 +29,7 @@ func (uc *WorkspaceRuntime) Reload(ctx context.Context, wk *biz.Workspace) error
 	obj.SetName(wk.Name)
 	obj.SetNamespace(biz.ClusterNamespace_cloudcopilot.String())
 	SetSpec(obj, wk)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := uc.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
@@ -55,7 +57,7 @@ func (uc *WorkspaceRuntime) Delete(ctx context.Context, wk *biz.Workspace) error
 	obj.SetName(wk.Name)
 	obj.SetNamespace(biz.ClusterNamespace_cloudcopilot.String())
 	SetSpec(obj, wk)
-	dynamicClient, err := GetKubeDynamicClient()
+	dynamicClient, err := uc.clients.DynamicClient(ctx, 0)
 	if err != nil {
 		return err
 	}
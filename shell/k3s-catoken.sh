@@ -1,5 +1,53 @@
 #!/bin/bash
 set -e
 
-# In the master node
-sudo cat /var/lib/rancher/k3s/server/node-token
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
+fi
+
+action=$1
+
+token_path=/var/lib/rancher/k3s/server/node-token
+
+case $action in
+get-token)
+      if [ ! -f $token_path ]; then
+            log "Error: $token_path is not found."
+            exit 1
+      fi
+      cat $token_path
+      ;;
+*)
+      log "Error: Unsupported action $action"
+      exit 1
+      ;;
+esac
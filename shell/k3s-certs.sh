@@ -0,0 +1,69 @@
+#!/bin/bash
+set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+ACTION=$1
+
+if [ -z "$ACTION" ]; then
+      log "Error: Action is required."
+      exit 1
+fi
+
+TLS_DIR=/var/lib/rancher/k3s/server/tls
+
+# prints one "name|base64 pem" line per certificate
+function list() {
+      for cert in $TLS_DIR/*.crt $TLS_DIR/etcd/*.crt; do
+            if [ ! -f "$cert" ]; then
+                  continue
+            fi
+            name=$(basename "$cert" .crt)
+            if [[ "$cert" == $TLS_DIR/etcd/* ]]; then
+                  name="etcd-$name"
+            fi
+            echo "$name|$(base64 -w0 "$cert")"
+      done
+}
+
+# k3s rotates the leaf certificates while the server is stopped, the cas are kept
+function renew() {
+      systemctl stop k3s
+      if ! k3s certificate rotate; then
+            systemctl start k3s
+            log "Error: Failed to rotate certificates."
+            exit 1
+      fi
+      systemctl start k3s
+      for i in $(seq 1 60); do
+            if k3s kubectl get --raw=/readyz &>/dev/null; then
+                  log "Certificates renewed."
+                  return
+            fi
+            sleep 2
+      done
+      log "Error: Api server is not ready after the renewal."
+      exit 1
+}
+
+case $ACTION in
+list)
+      list
+      ;;
+renew)
+      renew
+      ;;
+*)
+      log "Error: Unsupported action $ACTION"
+      exit 1
+      ;;
+esac
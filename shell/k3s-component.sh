@@ -1,2 +1,61 @@
 #!/bin/bash
 set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
+fi
+
+RESOURCE=${1:-"$ORIGINAL_HOME/resource"}
+K3S_VERSION=$2
+
+# installs the k3s binary and airgap images shipped with the resources,
+# without them k3s.sh downloads the release during init and join
+k3s_path="$RESOURCE/$ARCH/k3s/$K3S_VERSION"
+if [ -z "$K3S_VERSION" ] || [ ! -f "$k3s_path/k3s" ]; then
+      log "K3s $K3S_VERSION not found in resources, it will be downloaded."
+      exit 0
+fi
+
+if ! install -m 755 "$k3s_path/k3s" /usr/local/bin/k3s; then
+      log "Error: Failed to install k3s"
+      exit 1
+fi
+
+images_dir=/var/lib/rancher/k3s/agent/images
+mkdir -p $images_dir
+for images in "$k3s_path"/k3s-airgap-images-*; do
+      if [ -f "$images" ]; then
+            cp "$images" $images_dir/
+      fi
+done
+
+log "K3s $K3S_VERSION installed."
@@ -1,46 +1,73 @@
 #!/bin/bash
 set -e
 
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
 ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
 
-masterNodeIp=$1
-if [ -z "$masterNodeIp" ]; then
-      echo "Master node IP is required"
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
       exit 1
 fi
 
-k3sShellPath=$2
-if [ -z "$k3sShellPath" ]; then
-      echo "K3s shell path is required"
-      exit 1
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
 fi
 
-podCidr=$3
-if [ -z "$podCidr" ]; then
-      echo "Pod CIDR is required"
+api_server=$1
+k3s_install_path=$2
+pod_cidr=$3
+service_cidr=$4
+k3s_version=${5:-"v1.33.1+k3s1"}
+
+if [ -z "$api_server" ]; then
+      log "Error: API server is required."
       exit 1
 fi
-
-serviceCidr=$4
-if [ -z "$serviceCidr" ]; then
-      echo "Service CIDR is required"
+if [ ! -f "$k3s_install_path" ]; then
+      log "Error: K3s install script $k3s_install_path not found."
+      exit 1
+fi
+if [ -z "$pod_cidr" ] || [ -z "$service_cidr" ]; then
+      log "Error: Pod CIDR and service CIDR are required."
       exit 1
 fi
 
-k3sVersion=$5
-if [ -z "$k3sVersion" ]; then
-      k3sVersion="v1.33.1+k3s1"
+# the packaged components are replaced by the cluster components, so every server runs with the same flags
+# --cluster-init starts the embedded etcd so more servers can join
+export INSTALL_K3S_EXEC="server --cluster-init --tls-san ${api_server} --cluster-cidr ${pod_cidr} --service-cidr ${service_cidr} --disable traefik --disable servicelb --disable metrics-server --disable local-storage --disable-helm-controller --disable-kube-proxy --flannel-backend=none --disable-network-policy"
+export INSTALL_K3S_VERSION="${k3s_version}"
+if [ -x /usr/local/bin/k3s ]; then
+      export INSTALL_K3S_SKIP_DOWNLOAD=true
 fi
 
-# server --config /etc/rancher/k3s/config.yaml
-# --cluster-init (cluster) Initialize a new cluster using embedded Etcd (default: false) [$K3S_CLUSTER_INIT]
-# --disable value [ --disable value ]  (components) Do not deploy packaged components and delete any deployed components (valid values: coredns, servicelb, traefik, local-storage, metrics-server, runtimes)
+log "Exec k3s init..."
 
-sudo INSTALL_K3S_EXEC="server --cluster-init --tls-san ${masterNodeIp} --cluster-cidr ${podCidr} --service-cidr ${serviceCidr} --disable traefik --disable servicelb --disable metrics-server --disable local-storage --disable-helm-controller --disable-kube-proxy --flannel-backend=none --disable-network-policy" INSTALL_K3S_VERSION="${k3sVersion}" bash $k3sShellPath
+if ! bash $k3s_install_path; then
+      log "Error: Failed to init k3s."
+      exit 1
+fi
 
-mkdir -p $HOME/.kube
-sudo cp /etc/rancher/k3s/k3s.yaml $HOME/.kube/config
-sudo chown $(id -u):$(id -g) $HOME/.kube/config
-echo "K3s installed successfully"
+log "K3s init success."
 
-# sudo INSTALL_K3S_EXEC="server --cluster-init --tls-san 192.168.90.149 --disable traefik --disable servicelb --disable metrics-server --disable local-storage --disable-helm-controller --disable-kube-proxy --flannel-backend=none --disable-network-policy" INSTALL_K3S_VERSION="v1.33.1+k3s1" bash /home/frambo/k3s.sh
+rm -f $ORIGINAL_HOME/.kube/config && mkdir -p $ORIGINAL_HOME/.kube && cp /etc/rancher/k3s/k3s.yaml $ORIGINAL_HOME/.kube/config && chown $ORIGINAL_USER:$ORIGINAL_USER $ORIGINAL_HOME/.kube/config
@@ -1,32 +1,82 @@
 #!/bin/bash
 set -e
 
-k3sUrl=$1
-if [ -z "$k3sUrl" ]; then
-      echo "K3s server URL is required"
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
       exit 1
-fi
+      ;;
+esac
 
-k3sToken=$2
-if [ -z "$k3sToken" ]; then
-      echo "K3s server token is required"
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
       exit 1
 fi
 
-k3sShellPath=$3
-if [ -z "$k3sShellPath" ]; then
-      echo "K3s shell path is required"
-      exit 1
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
 fi
 
-k3sMaster=$4
+api_server=$1
+token=$2
+k3s_install_path=$3
+k3s_version=${4:-"v1.33.1+k3s1"}
+is_control_plane=$5
+pod_cidr=$6
+service_cidr=$7
 
-K3S_URL=$k3sUrl
+if [ -z "$api_server" ]; then
+      log "Error: API server is required."
+      exit 1
+fi
+if [ -z "$token" ]; then
+      log "Error: Token is required."
+      exit 1
+fi
+if [ ! -f "$k3s_install_path" ]; then
+      log "Error: K3s install script $k3s_install_path not found."
+      exit 1
+fi
 
-K3S_TOKEN=$k3sToken
+export K3S_TOKEN="${token}"
+export INSTALL_K3S_VERSION="${k3s_version}"
+if [ -x /usr/local/bin/k3s ]; then
+      export INSTALL_K3S_SKIP_DOWNLOAD=true
+fi
 
-bash $k3sShellPath
+if [ -n "$is_control_plane" ]; then
+      log "Joining as server node..."
+      if [ -z "$pod_cidr" ] || [ -z "$service_cidr" ]; then
+            log "Error: Pod CIDR and service CIDR are required."
+            exit 1
+      fi
+      export INSTALL_K3S_EXEC="server --server https://${api_server}:6443 --tls-san ${api_server} --cluster-cidr ${pod_cidr} --service-cidr ${service_cidr} --disable traefik --disable servicelb --disable metrics-server --disable local-storage --disable-helm-controller --disable-kube-proxy --flannel-backend=none --disable-network-policy"
+else
+      log "Joining as agent node..."
+      export K3S_URL="https://${api_server}:6443"
+      export INSTALL_K3S_EXEC="agent"
+fi
 
-# curl -sfL https://get.k3s.io | K3S_URL=https://192.168.1.100:6443 K3S_TOKEN=K10b9e63... sh -
+if ! bash $k3s_install_path; then
+      log "Error: Failed to join k3s cluster."
+      exit 1
+fi
 
-# curl -sfL https://get.k3s.io | INSTALL_K3S_EXEC="server --server https://192.168.1.100:6443" K3S_TOKEN=K10b9e63... sh -
+log "K3s join success."
@@ -1,6 +1,49 @@
 #!/bin/bash
 set -e
 
-# bash /usr/local/bin/k3s-killall.sh
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
 
-bash /usr/local/bin/k3s-uninstall.sh
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
+fi
+
+log "Exec k3s reset..."
+
+if [ -f /usr/local/bin/k3s-uninstall.sh ]; then
+      bash /usr/local/bin/k3s-uninstall.sh
+elif [ -f /usr/local/bin/k3s-agent-uninstall.sh ]; then
+      bash /usr/local/bin/k3s-agent-uninstall.sh
+else
+      log "K3s is not installed."
+fi
+
+rm -rf $ORIGINAL_HOME/.kube
+
+log "K3s reset success."
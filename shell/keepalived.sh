@@ -0,0 +1,103 @@
+#!/bin/bash
+set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+vip=$1
+node_ip=$2
+priority=${3:-"100"}
+router_id=${4:-"51"}
+
+if [ -z "$vip" ]; then
+      log "Error: Virtual ip is required."
+      exit 1
+fi
+if [ -z "$node_ip" ]; then
+      log "Error: Node ip is required."
+      exit 1
+fi
+
+interface=$(ip -o -4 addr show | awk -v ip="$node_ip" '{split($4, a, "/"); if (a[1] == ip) print $2}' | head -n1)
+if [ -z "$interface" ]; then
+      log "Error: No network interface holds $node_ip."
+      exit 1
+fi
+
+log "Install keepalived..."
+if ! command -v keepalived &>/dev/null; then
+      if command -v apt-get &>/dev/null; then
+            apt-get update -y && apt-get install -y keepalived
+      elif command -v dnf &>/dev/null; then
+            dnf install -y keepalived
+      elif command -v yum &>/dev/null; then
+            yum install -y keepalived
+      else
+            log "Error: No supported package manager found."
+            exit 1
+      fi
+fi
+
+mkdir -p /etc/keepalived
+
+cat <<CHECK >/etc/keepalived/check_apiserver.sh
+#!/bin/sh
+curl -sfk --max-time 2 https://localhost:6443/healthz -o /dev/null
+CHECK
+chmod +x /etc/keepalived/check_apiserver.sh
+
+# the check only lowers the priority so the virtual ip is still held while the first control plane is being initialized
+cat <<CONF >/etc/keepalived/keepalived.conf
+global_defs {
+    router_id LVS_KUBERNETES
+    enable_script_security
+    script_user root
+}
+vrrp_script check_apiserver {
+    script "/etc/keepalived/check_apiserver.sh"
+    interval 3
+    weight -20
+    fall 3
+    rise 2
+}
+vrrp_instance VI_KUBERNETES {
+    state BACKUP
+    interface $interface
+    virtual_router_id $router_id
+    priority $priority
+    advert_int 1
+    unicast_src_ip $node_ip
+    virtual_ipaddress {
+        $vip
+    }
+    track_script {
+        check_apiserver
+    }
+}
+CONF
+
+systemctl enable keepalived && systemctl restart keepalived
+
+log "Keepalived started, virtual ip $vip on $interface."
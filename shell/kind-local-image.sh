@@ -1,14 +1,15 @@
-# /bin/bash
+#!/bin/bash
 set -e
 
 # Check if image name is provided
 if [ $# -eq 0 ]; then
       echo "Error: Please provide an image name"
-      echo "Usage: $0 <image-name>"
+      echo "Usage: $0 <image-name> [kind-cluster-name]"
       exit 1
 fi
 
 IMAGE_PATTERN=$1
+CLUSTER_NAME=${2:-"kind"}
 # Find matching images
 MATCHING_IMAGES=$(docker images --format "{{.Repository}}:{{.Tag}}" | grep -i "$IMAGE_PATTERN" || echo "")
 
@@ -17,8 +18,15 @@ if [ -z "$MATCHING_IMAGES" ]; then
       exit 1
 fi
 
-# If multiple images found, let user choose
-if [ $(echo "$MATCHING_IMAGES" | wc -l) -gt 1 ]; then
+# An exact match wins, otherwise let user choose when running in a terminal
+if echo "$MATCHING_IMAGES" | grep -qx "$IMAGE_PATTERN"; then
+      IMAGE_NAME=$IMAGE_PATTERN
+elif [ $(echo "$MATCHING_IMAGES" | wc -l) -gt 1 ]; then
+      if [ ! -t 0 ]; then
+            echo "Error: Multiple images found matching pattern '$IMAGE_PATTERN':"
+            echo "$MATCHING_IMAGES"
+            exit 1
+      fi
       echo "Multiple images found:"
       i=1
       while IFS= read -r image; do
@@ -39,9 +47,9 @@ else
 fi
 
 # Get all kind nodes
-NODES=$(kind get nodes 2>/dev/null || echo "")
+NODES=$(kind get nodes --name "$CLUSTER_NAME" 2>/dev/null || echo "")
 if [ -z "$NODES" ]; then
-      echo "Error: No kind nodes found. Is your kind cluster running?"
+      echo "Error: No kind nodes found. Is your kind cluster $CLUSTER_NAME running?"
       exit 1
 fi
 
@@ -49,7 +57,7 @@ fi
 echo "Loading image $IMAGE_NAME to kind nodes..."
 for node in $NODES; do
       echo "Loading to node: $node"
-      kind load docker-image "$IMAGE_NAME" --nodes "$node"
+      kind load docker-image "$IMAGE_NAME" --name "$CLUSTER_NAME" --nodes "$node"
 done
 
 echo "Image successfully loaded to all nodes"
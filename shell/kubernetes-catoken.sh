@@ -13,7 +13,7 @@ if [ -z "$ACTION" ]; then
       exit 1
 fi
 
-if [ "$ACTION" != "get-ca-hash" ] && [ "$ACTION" != "get-token" ]; then
+if [ "$ACTION" != "get-ca-hash" ] && [ "$ACTION" != "get-token" ] && [ "$ACTION" != "get-certificate-key" ]; then
       log "Error: Action is invalid."
       exit 1
 fi
@@ -81,6 +81,16 @@ function getToken() {
       echo "$token"
 }
 
+function getCertificateKey() {
+      local certificate_key
+      certificate_key=$(kubeadm init phase upload-certs --upload-certs 2>/dev/null | tail -n1)
+      if [ -z "$certificate_key" ]; then
+            log "Error: Failed to upload certificates"
+            exit 1
+      fi
+      echo "$certificate_key"
+}
+
 case $ACTION in
 get-ca-hash)
       getCaHash
@@ -88,6 +98,9 @@ get-ca-hash)
 get-token)
       getToken
       ;;
+get-certificate-key)
+      getCertificateKey
+      ;;
 esac
 
 exit 0
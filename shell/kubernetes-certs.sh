@@ -0,0 +1,111 @@
+#!/bin/bash
+set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+ACTION=$1
+
+if [ -z "$ACTION" ]; then
+      log "Error: Action is required."
+      exit 1
+fi
+
+KUBECONFIG_PATH=/etc/kubernetes/admin.conf
+PKI_DIR=/etc/kubernetes/pki
+MANIFESTS_DIR=/etc/kubernetes/manifests
+MANIFESTS_BACKUP_DIR=/etc/kubernetes/manifests-renew
+
+# prints one "name|base64 pem" line per certificate, the kubeconfig client certificates are named after their file
+function list() {
+      for cert in $PKI_DIR/*.crt $PKI_DIR/etcd/*.crt; do
+            if [ ! -f "$cert" ]; then
+                  continue
+            fi
+            name=$(basename "$cert" .crt)
+            if [[ "$cert" == $PKI_DIR/etcd/* ]]; then
+                  name="etcd-$name"
+            fi
+            echo "$name|$(base64 -w0 "$cert")"
+      done
+      for conf in admin super-admin controller-manager scheduler; do
+            if [ ! -f "/etc/kubernetes/$conf.conf" ]; then
+                  continue
+            fi
+            data=$(grep -m1 'client-certificate-data:' "/etc/kubernetes/$conf.conf" | awk '{print $2}')
+            if [ -n "$data" ]; then
+                  echo "$conf.conf|$data"
+            fi
+      done
+}
+
+# restarts a static pod by moving its manifest out until the container is gone
+function restartStaticPod() {
+      local component=$1
+      if [ ! -f $MANIFESTS_DIR/$component.yaml ]; then
+            return
+      fi
+      mkdir -p $MANIFESTS_BACKUP_DIR
+      mv $MANIFESTS_DIR/$component.yaml $MANIFESTS_BACKUP_DIR/$component.yaml
+      for i in $(seq 1 60); do
+            if [ -z "$(crictl ps --name "^$component\$" -q 2>/dev/null)" ]; then
+                  break
+            fi
+            sleep 2
+      done
+      mv $MANIFESTS_BACKUP_DIR/$component.yaml $MANIFESTS_DIR/$component.yaml
+      for i in $(seq 1 60); do
+            if [ -n "$(crictl ps --name "^$component\$" -q 2>/dev/null)" ]; then
+                  log "$component restarted."
+                  return
+            fi
+            sleep 2
+      done
+      log "Error: $component did not start again."
+      exit 1
+}
+
+function renew() {
+      if ! kubeadm certs renew all; then
+            log "Error: Failed to renew certificates."
+            exit 1
+      fi
+      for component in etcd kube-apiserver kube-controller-manager kube-scheduler; do
+            restartStaticPod $component
+      done
+      for i in $(seq 1 60); do
+            if kubectl --kubeconfig $KUBECONFIG_PATH get --raw=/readyz &>/dev/null; then
+                  break
+            fi
+            sleep 2
+      done
+      if ! kubectl --kubeconfig $KUBECONFIG_PATH get --raw=/readyz &>/dev/null; then
+            log "Error: Api server is not ready after the renewal."
+            exit 1
+      fi
+      if [ -n "$SUDO_USER" ] && [ -f "$(getent passwd "$SUDO_USER" | cut -d: -f6)/.kube/config" ]; then
+            cp -f $KUBECONFIG_PATH "$(getent passwd "$SUDO_USER" | cut -d: -f6)/.kube/config"
+      fi
+      log "Certificates renewed."
+}
+
+case $ACTION in
+list)
+      list
+      ;;
+renew)
+      renew
+      ;;
+*)
+      log "Error: Unsupported action $ACTION"
+      exit 1
+      ;;
+esac
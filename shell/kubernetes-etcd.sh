@@ -0,0 +1,222 @@
+#!/bin/bash
+set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
+fi
+
+ACTION=$1
+
+if [ -z "$ACTION" ]; then
+      log "Error: Action is required."
+      exit 1
+fi
+
+ETCD_POD="etcd-$(hostname)"
+KUBECONFIG_PATH=/etc/kubernetes/admin.conf
+MANIFESTS_DIR=/etc/kubernetes/manifests
+MANIFESTS_BACKUP_DIR=/etc/kubernetes/manifests-restore
+SNAPSHOT_DIR=$ORIGINAL_HOME/etcd-snapshot
+ETCD_DATA_DIR=/var/lib/etcd
+
+etcdctl() {
+      kubectl --kubeconfig $KUBECONFIG_PATH -n kube-system exec $ETCD_POD -- etcdctl \
+            --endpoints=https://127.0.0.1:2379 \
+            --cacert=/etc/kubernetes/pki/etcd/ca.crt \
+            --cert=/etc/kubernetes/pki/etcd/server.crt \
+            --key=/etc/kubernetes/pki/etcd/server.key \
+            "$@"
+}
+
+etcd_image() {
+      local manifest=$MANIFESTS_DIR/etcd.yaml
+      if [ ! -f $manifest ]; then
+            manifest=$MANIFESTS_BACKUP_DIR/etcd.yaml
+      fi
+      grep -m1 'image:' $manifest | awk '{print $2}'
+}
+
+# runs etcdctl or etcdutl from the etcd image, it works whether the etcd static pod is running or not
+etcd_run() {
+      local image
+      image=$(etcd_image)
+      if [ -z "$image" ]; then
+            log "Error: Etcd image not found."
+            exit 1
+      fi
+      ctr -n k8s.io run --rm --net-host \
+            --mount type=bind,src=/etc/kubernetes/pki/etcd,dst=/etc/kubernetes/pki/etcd,options=rbind:ro \
+            --mount type=bind,src=/var/lib,dst=/var/lib,options=rbind:rw \
+            --mount type=bind,src=$SNAPSHOT_DIR,dst=$SNAPSHOT_DIR,options=rbind:rw \
+            "$image" "etcd-$(date +%s%N)" "$@"
+}
+
+function snapshotSave() {
+      local name=$1
+      if [ -z "$name" ]; then
+            log "Error: Snapshot name is required."
+            exit 1
+      fi
+      mkdir -p $SNAPSHOT_DIR
+      if ! etcd_run etcdctl \
+            --endpoints=https://127.0.0.1:2379 \
+            --cacert=/etc/kubernetes/pki/etcd/ca.crt \
+            --cert=/etc/kubernetes/pki/etcd/server.crt \
+            --key=/etc/kubernetes/pki/etcd/server.key \
+            snapshot save $SNAPSHOT_DIR/$name; then
+            log "Error: Failed to save snapshot $name."
+            exit 1
+      fi
+      chown -R $ORIGINAL_USER:$ORIGINAL_USER $SNAPSHOT_DIR
+      log "Snapshot $name saved."
+}
+
+# prints the snapshot status json as the last line
+function snapshotStatus() {
+      local name=$1
+      if [ ! -f $SNAPSHOT_DIR/$name ]; then
+            log "Error: Snapshot $name not found."
+            exit 1
+      fi
+      etcd_run etcdutl snapshot status $SNAPSHOT_DIR/$name -w json
+}
+
+function snapshotRemove() {
+      rm -f $SNAPSHOT_DIR/$1
+}
+
+function stopControlPlane() {
+      mkdir -p $MANIFESTS_BACKUP_DIR
+      for manifest in kube-apiserver.yaml etcd.yaml; do
+            if [ -f $MANIFESTS_DIR/$manifest ]; then
+                  mv $MANIFESTS_DIR/$manifest $MANIFESTS_BACKUP_DIR/$manifest
+            fi
+      done
+      for i in $(seq 1 60); do
+            if [ -z "$(crictl ps --name '^etcd$' -q 2>/dev/null)" ] && [ -z "$(crictl ps --name '^kube-apiserver$' -q 2>/dev/null)" ]; then
+                  log "Control plane stopped."
+                  return
+            fi
+            sleep 2
+      done
+      log "Error: Control plane did not stop."
+      exit 1
+}
+
+function startControlPlane() {
+      for manifest in etcd.yaml kube-apiserver.yaml; do
+            if [ -f $MANIFESTS_BACKUP_DIR/$manifest ]; then
+                  mv $MANIFESTS_BACKUP_DIR/$manifest $MANIFESTS_DIR/$manifest
+            fi
+      done
+      log "Control plane started."
+}
+
+function snapshotRestore() {
+      local name=$1
+      local member_name=$2
+      local initial_cluster=$3
+      local peer_url=$4
+      local cluster_token=$5
+      if [ ! -f $SNAPSHOT_DIR/$name ]; then
+            log "Error: Snapshot $name not found."
+            exit 1
+      fi
+      if [ -f $MANIFESTS_DIR/etcd.yaml ]; then
+            log "Error: Etcd is still running, stop the control plane first."
+            exit 1
+      fi
+      rm -rf $ETCD_DATA_DIR-restore
+      if ! etcd_run etcdutl snapshot restore $SNAPSHOT_DIR/$name \
+            --name "$member_name" \
+            --initial-cluster "$initial_cluster" \
+            --initial-cluster-token "$cluster_token" \
+            --initial-advertise-peer-urls "$peer_url" \
+            --data-dir $ETCD_DATA_DIR-restore; then
+            log "Error: Failed to restore snapshot $name."
+            exit 1
+      fi
+      if [ -d $ETCD_DATA_DIR ]; then
+            mv $ETCD_DATA_DIR $ETCD_DATA_DIR-$(date +%s).bak
+      fi
+      mv $ETCD_DATA_DIR-restore $ETCD_DATA_DIR
+      log "Snapshot $name restored."
+}
+
+function memberRemove() {
+      local member_name=$1
+      if [ -z "$member_name" ]; then
+            log "Error: Member name is required."
+            exit 1
+      fi
+      local member_id
+      member_id=$(etcdctl member list | awk -F', ' -v name="$member_name" '$3 == name {print $1}')
+      if [ -z "$member_id" ]; then
+            log "Member $member_name not found, nothing to remove."
+            exit 0
+      fi
+      if ! etcdctl member remove "$member_id"; then
+            log "Error: Failed to remove member $member_name."
+            exit 1
+      fi
+      log "Member $member_name removed."
+}
+
+case $ACTION in
+member-remove)
+      memberRemove "$2"
+      ;;
+snapshot-save)
+      snapshotSave "$2"
+      ;;
+snapshot-status)
+      snapshotStatus "$2"
+      ;;
+snapshot-remove)
+      snapshotRemove "$2"
+      ;;
+snapshot-restore)
+      snapshotRestore "$2" "$3" "$4" "$5" "$6"
+      ;;
+stop-control-plane)
+      stopControlPlane
+      ;;
+start-control-plane)
+      startControlPlane
+      ;;
+*)
+      log "Error: Action is invalid."
+      exit 1
+      ;;
+esac
+
+exit 0
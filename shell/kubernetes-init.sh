@@ -49,7 +49,7 @@ if [ ! -f $cluster_config_path ]; then
       exit 1
 fi
 
-if ! kubeadm init --config $cluster_config_path --v=5; then
+if ! kubeadm init --config $cluster_config_path --upload-certs --v=5; then
       log "Error: Failed to init cluster."
       kubeadm reset --force
       exit 1
@@ -38,6 +38,7 @@ api_server=$1
 caHash=$2
 token=$3
 is_control_plane=$4
+certificate_key=$5
 
 if [ -z "$api_server" ]; then
       log "Error: API server is required."
@@ -59,6 +60,9 @@ join_command="kubeadm join $api_server:6443 --token $token --discovery-token-ca-
 if [ -n "$is_control_plane" ]; then
       log "Joining as control plane node..."
       join_command="$join_command --control-plane"
+      if [ -n "$certificate_key" ]; then
+            join_command="$join_command --certificate-key $certificate_key"
+      fi
 else
       log "Joining as worker node..."
 fi
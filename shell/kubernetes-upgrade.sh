@@ -0,0 +1,89 @@
+#!/bin/bash
+set -e
+
+log() {
+      local message="$1"
+      echo "$(date +'%Y-%m-%d %H:%M:%S') - $message"
+}
+
+ARCH=$(uname -m)
+case $ARCH in
+aarch64)
+      ARCH="arm64"
+      ;;
+x86_64)
+      ARCH="amd64"
+      ;;
+*)
+      log "Error: Unsupported architecture $ARCH. Supported architectures are: aarch64, x86_64"
+      exit 1
+      ;;
+esac
+
+OS="$(uname -s | tr '[:upper:]' '[:lower:]')"
+if [[ "$OS" != "linux" ]]; then
+      log "Error: Unsupported OS $OS"
+      exit 1
+fi
+
+if [ -n "$SUDO_USER" ]; then
+      ORIGINAL_USER=$SUDO_USER
+      ORIGINAL_HOME=$(getent passwd "$SUDO_USER" | cut -d: -f6)
+else
+      ORIGINAL_USER=$USER
+      ORIGINAL_HOME=$HOME
+fi
+
+RESOURCE=${1:-"$ORIGINAL_HOME/resource"}
+KUBERNETES_VERSION=${2:-""}
+# apply: first control plane node, node: other control plane and worker nodes
+UPGRADE_MODE=${3:-"node"}
+
+if [ -z "$KUBERNETES_VERSION" ]; then
+      log "Error: Kubernetes version is required."
+      exit 1
+fi
+
+kubernetesPath="$RESOURCE/$ARCH/kubernetes/$KUBERNETES_VERSION"
+if [ ! -d "$kubernetesPath" ] || [ ! -r "$kubernetesPath" ]; then
+      log "Error: Directory $kubernetesPath does not exist or is not readable"
+      exit 1
+fi
+
+log "Upgrade kubeadm to $KUBERNETES_VERSION..."
+if ! install -m 755 "$kubernetesPath/kubeadm" /usr/local/bin/kubeadm; then
+      log "Error: Failed to install kubeadm"
+      exit 1
+fi
+
+if [ "$UPGRADE_MODE" == "apply" ]; then
+      if ! kubeadm upgrade apply "$KUBERNETES_VERSION" --yes --v=5; then
+            log "Error: Failed to upgrade control plane."
+            exit 1
+      fi
+else
+      if ! kubeadm upgrade node --v=5; then
+            log "Error: Failed to upgrade node."
+            exit 1
+      fi
+fi
+
+log "Upgrade kubelet to $KUBERNETES_VERSION..."
+if ! install -m 755 "$kubernetesPath/kubelet" /usr/local/bin/kubelet; then
+      log "Error: Failed to install kubelet"
+      exit 1
+fi
+
+if ! systemctl daemon-reload; then
+      log "Error: Failed to reload systemd daemon"
+      exit 1
+fi
+
+if ! systemctl restart kubelet; then
+      log "Error: Failed to restart kubelet service"
+      exit 1
+fi
+
+log "Node upgrade to $KUBERNETES_VERSION success."
+
+exit 0
@@ -0,0 +1,178 @@
+#!/bin/bash
+
+# preflight.sh <role> <ports> <master address> <registry host> <controller unix time>
+# prints one "status|check|message" line per check, status is pass, warn or fail, "-" is an empty argument
+
+arg() {
+      if [ "$1" != "-" ]; then
+            echo "$1"
+      fi
+}
+
+ROLE=$(arg "$1")
+PORTS=$(arg "$2")
+MASTER_ADDRESS=$(arg "$3")
+REGISTRY_HOST=$(arg "$4")
+CONTROLLER_TIME=$(arg "$5")
+
+MIN_DISK_GB=10
+WARN_DISK_GB=20
+MAX_CLOCK_SKEW_SECONDS=60
+
+report() {
+      echo "$1|$2|$3"
+}
+
+# os
+if [ -f /etc/os-release ]; then
+      . /etc/os-release
+      case "$ID" in
+      ubuntu | debian | centos | rhel | rocky | almalinux | fedora | openEuler | openeuler | kylin | anolis | ol)
+            report pass os "$PRETTY_NAME"
+            ;;
+      *)
+            report warn os "$PRETTY_NAME is not a tested distribution"
+            ;;
+      esac
+else
+      report fail os "/etc/os-release not found"
+fi
+
+# kernel
+kernel=$(uname -r)
+kernel_major=$(echo "$kernel" | cut -d. -f1)
+kernel_minor=$(echo "$kernel" | cut -d. -f2)
+if [ "$kernel_major" -lt 3 ] || { [ "$kernel_major" -eq 3 ] && [ "$kernel_minor" -lt 10 ]; }; then
+      report fail kernel "$kernel is older than 3.10"
+elif [ "$kernel_major" -lt 4 ] || { [ "$kernel_major" -eq 4 ] && [ "$kernel_minor" -lt 19 ]; }; then
+      report warn kernel "$kernel is older than the recommended 4.19"
+else
+      report pass kernel "$kernel"
+fi
+
+# cpu and memory, kubeadm requires 2 cpus and 1700M on control plane nodes
+cpu_cores=$(nproc)
+memory_mb=$(($(grep MemTotal /proc/meminfo | awk '{print $2}') / 1024))
+if [ "$ROLE" == "master" ] && { [ "$cpu_cores" -lt 2 ] || [ "$memory_mb" -lt 1700 ]; }; then
+      report fail resources "${cpu_cores} cpus and ${memory_mb}M memory, a master needs 2 cpus and 1700M"
+else
+      report pass resources "${cpu_cores} cpus and ${memory_mb}M memory"
+fi
+
+# swap
+if [ -n "$(swapon --show 2>/dev/null)" ]; then
+      report warn swap "swap is on, nodeinit.sh turns it off"
+else
+      report pass swap "swap is off"
+fi
+
+# kernel modules
+for module in overlay br_netfilter; do
+      if [ -d "/sys/module/$module" ]; then
+            report pass "module_$module" "loaded"
+      elif modinfo "$module" &>/dev/null || grep -qs "/$module.ko" "/lib/modules/$(uname -r)/modules.dep" "/lib/modules/$(uname -r)/modules.builtin"; then
+            report pass "module_$module" "available"
+      else
+            report fail "module_$module" "kernel module $module not found"
+      fi
+done
+
+# cgroup
+cgroup_fs=$(stat -fc %T /sys/fs/cgroup 2>/dev/null)
+case "$cgroup_fs" in
+cgroup2fs)
+      report pass cgroup "cgroup v2"
+      ;;
+tmpfs)
+      report warn cgroup "cgroup v1 is in maintenance mode since kubernetes 1.31"
+      ;;
+*)
+      report fail cgroup "cgroup filesystem not found"
+      ;;
+esac
+
+# disk
+disk_path=/var/lib
+if [ ! -d "$disk_path" ]; then
+      disk_path=/
+fi
+free_gb=$(df -BG --output=avail "$disk_path" 2>/dev/null | tail -n 1 | tr -dc '0-9')
+if [ -z "$free_gb" ]; then
+      report warn disk "free space of $disk_path unknown"
+elif [ "$free_gb" -lt "$MIN_DISK_GB" ]; then
+      report fail disk "${free_gb}G free on $disk_path, ${MIN_DISK_GB}G required"
+elif [ "$free_gb" -lt "$WARN_DISK_GB" ]; then
+      report warn disk "${free_gb}G free on $disk_path, ${WARN_DISK_GB}G recommended"
+else
+      report pass disk "${free_gb}G free on $disk_path"
+fi
+
+# ports
+for port in ${PORTS//,/ }; do
+      if command -v ss &>/dev/null; then
+            listener=$(ss -Hltnp "sport = :$port" 2>/dev/null | head -n 1)
+      elif command -v netstat &>/dev/null; then
+            listener=$(netstat -ltnp 2>/dev/null | awk -v port=":$port" '$4 ~ port"$"' | head -n 1)
+      else
+            report warn "port_$port" "neither ss nor netstat found"
+            continue
+      fi
+      if [ -z "$listener" ]; then
+            report pass "port_$port" "free"
+            continue
+      fi
+      process=$(echo "$listener" | grep -o '(("[^"]*"' | head -n 1 | tr -d '("')
+      if [ -z "$process" ]; then
+            process=$(echo "$listener" | awk '{print $NF}' | cut -d/ -f2)
+      fi
+      case "$process" in
+      kubelet | kube-apiserver | kube-controller | kube-scheduler | kube-proxy | etcd | k3s | k3s-server | k3s-agent)
+            report pass "port_$port" "in use by $process of the installed cluster"
+            ;;
+      *)
+            report fail "port_$port" "in use by ${process:-another process}"
+            ;;
+      esac
+done
+
+# time sync
+synchronized=""
+if command -v timedatectl &>/dev/null; then
+      synchronized=$(timedatectl show -p NTPSynchronized --value 2>/dev/null)
+fi
+if [ "$synchronized" != "yes" ] && command -v chronyc &>/dev/null && chronyc tracking 2>/dev/null | grep -q "Leap status *: Normal"; then
+      synchronized="yes"
+fi
+if [ -n "$CONTROLLER_TIME" ]; then
+      skew=$(($(date +%s) - CONTROLLER_TIME))
+      skew=${skew#-}
+fi
+if [ -n "$skew" ] && [ "$skew" -gt "$MAX_CLOCK_SKEW_SECONDS" ]; then
+      report fail time_sync "clock is ${skew}s off, certificates and etcd need synchronized clocks"
+elif [ "$synchronized" != "yes" ]; then
+      report warn time_sync "no synchronized ntp source"
+else
+      report pass time_sync "synchronized"
+fi
+
+# dns
+if ! grep -q "^nameserver" /etc/resolv.conf 2>/dev/null; then
+      report fail dns "no nameserver in /etc/resolv.conf"
+elif [ -n "$REGISTRY_HOST" ] && ! getent hosts "$REGISTRY_HOST" &>/dev/null; then
+      report warn dns "$REGISTRY_HOST does not resolve, images must be loaded offline"
+else
+      report pass dns "$(grep -m 1 "^nameserver" /etc/resolv.conf | awk '{print $2}')"
+fi
+
+# master connectivity
+if [ -z "$MASTER_ADDRESS" ]; then
+      report pass master "this node bootstraps the control plane"
+else
+      master_host=${MASTER_ADDRESS%:*}
+      master_port=${MASTER_ADDRESS##*:}
+      if timeout 3 bash -c "</dev/tcp/$master_host/$master_port" &>/dev/null; then
+            report pass master "$MASTER_ADDRESS reachable"
+      else
+            report fail master "$MASTER_ADDRESS unreachable"
+      fi
+fi
@@ -0,0 +1,284 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// encryptedPrefix marks ciphertext so values written before encryption was enabled are still readable
+const encryptedPrefix = "enc:v1:"
+
+// envelopePrefix marks a value sealed by a SecretBox, eg: enc:v2:<key id>:<wrapped data key>:<ciphertext>
+const envelopePrefix = "enc:v2:"
+
+// EncryptString seals the plaintext with AES-256-GCM, the key is derived from the secret
+func EncryptString(secret, plaintext string) (string, error) {
+	if plaintext == "" || IsEncryptedString(plaintext) {
+		return plaintext, nil
+	}
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(gcm, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// DecryptString opens a value sealed by EncryptString, a value without the prefix is returned as is
+func DecryptString(secret, ciphertext string) (string, error) {
+	if !strings.HasPrefix(ciphertext, encryptedPrefix) {
+		return ciphertext, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, encryptedPrefix))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid ciphertext")
+	}
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(gcm, data)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt failed")
+	}
+	return string(plaintext), nil
+}
+
+func IsEncryptedString(s string) bool {
+	return strings.HasPrefix(s, encryptedPrefix) || strings.HasPrefix(s, envelopePrefix)
+}
+
+func newGCM(secret string) (cipher.AEAD, error) {
+	if secret == "" {
+		return nil, errors.New("encryption secret is empty")
+	}
+	key := sha256.Sum256([]byte(secret))
+	return newKeyGCM(key[:])
+}
+
+func newKeyGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(gcm cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, gcm.NonceSize())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(gcm cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("invalid ciphertext")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// KeyProvider holds the key encryption keys the data keys of sealed values are wrapped with,
+// a kms client implements it with the keys kept on the kms side
+type KeyProvider interface {
+	CurrentKeyId() string
+	WrapKey(keyId string, dataKey []byte) ([]byte, error)
+	UnwrapKey(keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKeyProvider is the local stand-in of a kms, the keys are kept in memory
+type LocalKeyProvider struct {
+	currentKeyId string
+	keys         map[string][]byte
+}
+
+// localKeyFile is the key file of the local key provider, a key is rotated by adding
+// a new key, pointing current at it and re-sealing the stored secrets
+type localKeyFile struct {
+	Current string            `yaml:"current"`
+	Keys    map[string]string `yaml:"keys"` // key id to base64 encoded 32 byte key
+}
+
+func NewLocalKeyProvider(currentKeyId string, keys map[string][]byte) (*LocalKeyProvider, error) {
+	if _, ok := keys[currentKeyId]; !ok {
+		return nil, errors.Errorf("current key %s not found", currentKeyId)
+	}
+	for keyId, key := range keys {
+		if keyId == "" || strings.Contains(keyId, ":") {
+			return nil, errors.Errorf("key id %q must be non empty and without ':'", keyId)
+		}
+		if len(key) != 32 {
+			return nil, errors.Errorf("key %s must be 32 bytes", keyId)
+		}
+	}
+	return &LocalKeyProvider{currentKeyId: currentKeyId, keys: keys}, nil
+}
+
+// NewSecretKeyProvider derives a single key from the secret, used when no key file is configured
+func NewSecretKeyProvider(keyId, secret string) (*LocalKeyProvider, error) {
+	if secret == "" {
+		return nil, errors.New("encryption secret is empty")
+	}
+	key := sha256.Sum256([]byte(secret))
+	return NewLocalKeyProvider(keyId, map[string][]byte{keyId: key[:]})
+}
+
+// LoadLocalKeyProvider reads the yaml key file, eg:
+// current: "2026-10"
+// keys:
+//
+//	"2026-10": <base64 32 bytes>
+//	"2026-01": <base64 32 bytes>
+func LoadLocalKeyProvider(path string) (*LocalKeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read key file failed")
+	}
+	file := &localKeyFile{}
+	err = yaml.Unmarshal(content, file)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse key file failed")
+	}
+	keys := make(map[string][]byte)
+	for keyId, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode key %s failed", keyId)
+		}
+		keys[keyId] = key
+	}
+	return NewLocalKeyProvider(file.Current, keys)
+}
+
+// AddSecretKey adds a key derived from the secret unless the key id is taken, it only opens values sealed before
+func (p *LocalKeyProvider) AddSecretKey(keyId, secret string) {
+	if _, ok := p.keys[keyId]; ok || secret == "" {
+		return
+	}
+	key := sha256.Sum256([]byte(secret))
+	p.keys[keyId] = key[:]
+}
+
+func (p *LocalKeyProvider) CurrentKeyId() string {
+	return p.currentKeyId
+}
+
+func (p *LocalKeyProvider) WrapKey(keyId string, dataKey []byte) ([]byte, error) {
+	gcm, err := p.keyGCM(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return seal(gcm, dataKey)
+}
+
+func (p *LocalKeyProvider) UnwrapKey(keyId string, wrappedKey []byte) ([]byte, error) {
+	gcm, err := p.keyGCM(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return open(gcm, wrappedKey)
+}
+
+func (p *LocalKeyProvider) keyGCM(keyId string) (cipher.AEAD, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, errors.Errorf("key %s not found", keyId)
+	}
+	return newKeyGCM(key)
+}
+
+// SecretBox seals every value with its own data key wrapped by the current key of the provider,
+// values sealed by EncryptString are opened with the legacy secret
+type SecretBox struct {
+	keys         KeyProvider
+	legacySecret string
+}
+
+func NewSecretBox(keys KeyProvider, legacySecret string) *SecretBox {
+	return &SecretBox{keys: keys, legacySecret: legacySecret}
+}
+
+// Encrypt seals the plaintext, an empty or already sealed value is returned as is
+func (b *SecretBox) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" || IsEncryptedString(plaintext) {
+		return plaintext, nil
+	}
+	dataKey := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, dataKey)
+	if err != nil {
+		return "", err
+	}
+	keyId := b.keys.CurrentKeyId()
+	wrappedKey, err := b.keys.WrapKey(keyId, dataKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "wrap data key with key %s failed", keyId)
+	}
+	gcm, err := newKeyGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(gcm, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s:%s:%s", envelopePrefix, keyId,
+		base64.StdEncoding.EncodeToString(wrappedKey), base64.StdEncoding.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt opens a value sealed by Encrypt or EncryptString, a plaintext value is returned as is
+func (b *SecretBox) Decrypt(ciphertext string) (string, error) {
+	if strings.HasPrefix(ciphertext, encryptedPrefix) {
+		return DecryptString(b.legacySecret, ciphertext)
+	}
+	if !strings.HasPrefix(ciphertext, envelopePrefix) {
+		return ciphertext, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(ciphertext, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("invalid ciphertext")
+	}
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.Wrap(err, "invalid ciphertext")
+	}
+	data, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.Wrap(err, "invalid ciphertext")
+	}
+	dataKey, err := b.keys.UnwrapKey(parts[0], wrappedKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "unwrap data key with key %s failed", parts[0])
+	}
+	gcm, err := newKeyGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(gcm, data)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt failed")
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether the value is plaintext or not sealed with the current key
+func (b *SecretBox) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	return !strings.HasPrefix(value, fmt.Sprintf("%s%s:", envelopePrefix, b.keys.CurrentKeyId()))
+}
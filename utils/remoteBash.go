@@ -3,10 +3,13 @@ package utils
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,52 +19,293 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
+// ErrHostKeyMismatch is returned when the server presents another host key than the pinned one
+var ErrHostKeyMismatch = errors.New("ssh host key does not match the pinned key, accept the new key only if the machine was rebuilt")
+
+// errHostKeyScanned aborts the handshake once ScanHostKey has the host key
+var errHostKeyScanned = errors.New("host key scanned")
+
 type RemoteBash struct {
 	server     Server
 	shellDir   string
 	sshClient  *ssh.Client
 	sshSession *ssh.Session
-	log        *log.Helper
+	// clients of the jump hosts the connection is tunneled through
+	jumpClients    []*ssh.Client
+	pinHostKey     func(hostKey string)
+	pinJumpHostKey func(addr, hostKey string)
+	log            *log.Helper
 }
 
 type Server struct {
-	Name       string `json:"name,omitempty"`
-	User       string `json:"user,omitempty"`
+	Name       string     `json:"name,omitempty"`
+	User       string     `json:"user,omitempty"`
+	Host       string     `json:"host,omitempty"`
+	Port       int32      `json:"port,omitempty"`
+	PrivateKey string     `json:"private_key,omitempty"`
+	Password   string     `json:"password,omitempty"`   // tried after the private key, either is required
+	HostKey    string     `json:"host_key,omitempty"`   // pinned host key in authorized_keys format, the first key seen is trusted when empty
+	JumpHosts  []JumpHost `json:"jump_hosts,omitempty"` // dialed in order like ssh ProxyJump
+}
+
+// JumpHost is a bastion the connection to the server is tunneled through
+type JumpHost struct {
 	Host       string `json:"host,omitempty"`
+	User       string `json:"user,omitempty"`
 	Port       int32  `json:"port,omitempty"`
-	PrivateKey string `json:"private_key,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"` // the private key of the server is used when empty
+	HostKey    string `json:"host_key,omitempty"`    // pinned host key in authorized_keys format, the first key seen is trusted when empty
+}
+
+func (j JumpHost) Addr() string {
+	port := j.Port
+	if port == 0 {
+		port = 22
+	}
+	return net.JoinHostPort(j.Host, fmt.Sprint(port))
+}
+
+// ParseProxyJump parses jump hosts in ssh ProxyJump syntax, eg: ops@bastion:2222,ops@10.0.0.5
+func ParseProxyJump(proxyJump string) ([]JumpHost, error) {
+	jumpHosts := make([]JumpHost, 0)
+	for _, hop := range strings.Split(proxyJump, ",") {
+		hop = strings.TrimSpace(hop)
+		if hop == "" {
+			continue
+		}
+		jumpHost := JumpHost{}
+		if at := strings.LastIndex(hop, "@"); at >= 0 {
+			jumpHost.User, hop = hop[:at], hop[at+1:]
+		}
+		jumpHost.Host = hop
+		if host, port, err := net.SplitHostPort(hop); err == nil {
+			portNumber, err := strconv.ParseInt(port, 10, 32)
+			if err != nil || portNumber <= 0 || portNumber > 65535 {
+				return nil, errors.Errorf("invalid jump host port %s", port)
+			}
+			jumpHost.Host, jumpHost.Port = host, int32(portNumber)
+		}
+		if jumpHost.Host == "" || jumpHost.User == "" {
+			return nil, errors.Errorf("jump host %s must be user@host[:port]", hop)
+		}
+		jumpHosts = append(jumpHosts, jumpHost)
+	}
+	return jumpHosts, nil
 }
 
 func NewRemoteBash(server Server, shellDir string, log *log.Helper) *RemoteBash {
 	return &RemoteBash{server: server, shellDir: shellDir, log: log}
 }
 
+// OnHostKeyPinned sets the callback storing the host key trusted on the first connection
+func (s *RemoteBash) OnHostKeyPinned(pinHostKey func(hostKey string)) *RemoteBash {
+	s.pinHostKey = pinHostKey
+	return s
+}
+
+// OnJumpHostKeyPinned sets the callback storing the host key of a jump host trusted on the first connection
+func (s *RemoteBash) OnJumpHostKeyPinned(pinJumpHostKey func(addr, hostKey string)) *RemoteBash {
+	s.pinJumpHostKey = pinJumpHostKey
+	return s
+}
+
+func (s *RemoteBash) hostKeyCallback(_ string, _ net.Addr, key ssh.PublicKey) error {
+	if s.server.HostKey == "" {
+		s.server.HostKey = MarshalHostKey(key)
+		s.log.Infof("%s/%s pinned ssh host key %s", s.server.Name, s.server.Host, ssh.FingerprintSHA256(key))
+		if s.pinHostKey != nil {
+			s.pinHostKey(s.server.HostKey)
+		}
+		return nil
+	}
+	return verifyHostKey(fmt.Sprintf("%s/%s", s.server.Name, s.server.Host), s.server.HostKey, key)
+}
+
+func (s *RemoteBash) jumpHostKeyCallback(jumpHost JumpHost) ssh.HostKeyCallback {
+	return func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		if jumpHost.HostKey == "" {
+			s.log.Infof("jump host %s pinned ssh host key %s", jumpHost.Addr(), ssh.FingerprintSHA256(key))
+			if s.pinJumpHostKey != nil {
+				s.pinJumpHostKey(jumpHost.Addr(), MarshalHostKey(key))
+			}
+			return nil
+		}
+		return verifyHostKey("jump host "+jumpHost.Addr(), jumpHost.HostKey, key)
+	}
+}
+
+func verifyHostKey(name, hostKey string, key ssh.PublicKey) error {
+	pinned, err := ParseHostKey(hostKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pinned.Marshal(), key.Marshal()) {
+		return errors.Wrapf(ErrHostKeyMismatch, "%s presented %s, pinned %s", name, ssh.FingerprintSHA256(key), ssh.FingerprintSHA256(pinned))
+	}
+	return nil
+}
+
+// dial connects to addr through the jump hosts, the clients of the hops are closed with the connection
+func (s *RemoteBash) dial(addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	var jumpClient *ssh.Client
+	for _, jumpHost := range s.server.JumpHosts {
+		privateKey := jumpHost.PrivateKey
+		if privateKey == "" {
+			privateKey = s.server.PrivateKey
+		}
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		if err != nil {
+			s.closeJumpClients()
+			return nil, errors.Wrapf(err, "invalid private key of jump host %s", jumpHost.Addr())
+		}
+		jumpClient, err = dialThrough(jumpClient, jumpHost.Addr(), &ssh.ClientConfig{
+			User:            jumpHost.User,
+			HostKeyCallback: s.jumpHostKeyCallback(jumpHost),
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			Timeout:         config.Timeout,
+		})
+		if err != nil {
+			s.closeJumpClients()
+			return nil, errors.Wrapf(err, "failed to connect jump host %s", jumpHost.Addr())
+		}
+		s.jumpClients = append(s.jumpClients, jumpClient)
+	}
+	client, err := dialThrough(jumpClient, addr, config)
+	if err != nil {
+		s.closeJumpClients()
+		return nil, err
+	}
+	return client, nil
+}
+
+// dialThrough opens the ssh connection over the tunnel of the jump client, directly when there is none
+func dialThrough(jumpClient *ssh.Client, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if jumpClient == nil {
+		return ssh.Dial("tcp", addr, config)
+	}
+	conn, err := jumpClient.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(clientConn, chans, reqs), nil
+}
+
+func (s *RemoteBash) closeJumpClients() {
+	for i := len(s.jumpClients) - 1; i >= 0; i-- {
+		s.jumpClients[i].Close()
+	}
+	s.jumpClients = nil
+}
+
+// hostKeyAlgorithms asks the server for the type of the pinned key, it may hold keys of other types too
+func (s *RemoteBash) hostKeyAlgorithms() []string {
+	if s.server.HostKey == "" {
+		return nil
+	}
+	pinned, err := ParseHostKey(s.server.HostKey)
+	if err != nil {
+		return nil
+	}
+	if pinned.Type() == ssh.KeyAlgoRSA {
+		return []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	}
+	return []string{pinned.Type()}
+}
+
 func (s *RemoteBash) connections() (*ssh.Session, error) {
-	signer, err := ssh.ParsePrivateKey([]byte(s.server.PrivateKey))
+	auth, err := s.authMethods()
 	if err != nil {
 		return nil, err
 	}
-	sshClient, err := ssh.Dial("tcp", fmt.Sprintf("%s:%d", s.server.Host, s.server.Port), &ssh.ClientConfig{
-		User:            s.server.User,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Auth: []ssh.AuthMethod{
-			// ssh.Password("your_password"),
-			ssh.PublicKeys(signer),
-		},
-		Timeout: 3 * time.Second,
+	sshClient, err := s.dial(net.JoinHostPort(s.server.Host, fmt.Sprint(s.server.Port)), &ssh.ClientConfig{
+		User:              s.server.User,
+		HostKeyCallback:   s.hostKeyCallback,
+		HostKeyAlgorithms: s.hostKeyAlgorithms(),
+		Auth:              auth,
+		Timeout:           3 * time.Second,
 	})
+	if err != nil && s.server.HostKey != "" && strings.Contains(err.Error(), "no common algorithm for host key") {
+		return nil, errors.Wrapf(ErrHostKeyMismatch, "%s/%s no longer offers a key of the pinned type", s.server.Name, s.server.Host)
+	}
 	if err != nil {
 		return nil, err
 	}
 	s.sshClient = sshClient
 	session, err := sshClient.NewSession()
 	if err != nil {
+		s.close()
 		return nil, err
 	}
 	s.sshSession = session
 	return session, nil
 }
 
+// authMethods offers the private key first and the password after it
+func (s *RemoteBash) authMethods() ([]ssh.AuthMethod, error) {
+	auth := make([]ssh.AuthMethod, 0)
+	if s.server.PrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(s.server.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if s.server.Password != "" {
+		auth = append(auth, ssh.Password(s.server.Password))
+	}
+	if len(auth) == 0 {
+		return nil, errors.Errorf("%s/%s has no private key or password", s.server.Name, s.server.Host)
+	}
+	return auth, nil
+}
+
+// ScanHostKey returns the host key the server presents without authenticating or pinning it
+func (s *RemoteBash) ScanHostKey() (string, error) {
+	hostKey := ""
+	_, err := s.dial(net.JoinHostPort(s.server.Host, fmt.Sprint(s.server.Port)), &ssh.ClientConfig{
+		User: s.server.User,
+		HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			hostKey = MarshalHostKey(key)
+			return errHostKeyScanned
+		},
+		Timeout: 3 * time.Second,
+	})
+	s.closeJumpClients()
+	if hostKey != "" {
+		return hostKey, nil
+	}
+	if err == nil {
+		err = errors.New("no host key presented")
+	}
+	return "", errors.Wrapf(err, "scan ssh host key of %s/%s", s.server.Name, s.server.Host)
+}
+
+func MarshalHostKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+func ParseHostKey(hostKey string) (ssh.PublicKey, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pinned host key")
+	}
+	return key, nil
+}
+
+// HostKeyFingerprint returns the sha256 fingerprint of a host key in authorized_keys format
+func HostKeyFingerprint(hostKey string) string {
+	key, err := ParseHostKey(hostKey)
+	if err != nil {
+		return ""
+	}
+	return ssh.FingerprintSHA256(key)
+}
+
 func (s *RemoteBash) close() {
 	if s.sshSession != nil {
 		s.sshSession.Close()
@@ -69,6 +313,7 @@ func (s *RemoteBash) close() {
 	if s.sshClient != nil {
 		s.sshClient.Close()
 	}
+	s.closeJumpClients()
 }
 
 func (s *RemoteBash) Run(command string, args ...string) (stdout string, err error) {
@@ -94,7 +339,9 @@ func (s *RemoteBash) Run(command string, args ...string) (stdout string, err err
 	if stderr != "" {
 		s.log.Warnf("command execution produced stderr: %s", stderr)
 	}
-
+	if err != nil {
+		return stdout, errors.Wrapf(err, "%s/%s command %s failed: %s", s.server.Name, s.server.Host, command, strings.TrimSpace(stderr))
+	}
 	return stdout, nil
 }
 
@@ -174,6 +421,39 @@ func (s *RemoteBash) SftpFile(localFile, remoteFile string) error {
 	return nil
 }
 
+func (s *RemoteBash) SftpDownload(remoteFile, localFile string) error {
+	_, err := s.connections()
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	sftpClient, err := sftp.NewClient(s.sshClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create sftp client")
+	}
+	defer sftpClient.Close()
+	srcFile, err := sftpClient.Open(remoteFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to open remote file")
+	}
+	defer srcFile.Close()
+	err = os.MkdirAll(filepath.Dir(localFile), 0755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create local directory")
+	}
+	dstFile, err := os.Create(localFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to create local file")
+	}
+	defer dstFile.Close()
+	bytesCopied, err := srcFile.WriteTo(dstFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to copy file")
+	}
+	s.log.Infof("Copied %d bytes from %s to %s", bytesCopied, remoteFile, localFile)
+	return nil
+}
+
 func (s *RemoteBash) GetUserHome() (string, error) {
 	homePath, err := s.Run("echo", "$HOME")
 	if err != nil {
@@ -191,30 +471,24 @@ func (s *RemoteBash) GetRootHome() (string, error) {
 }
 
 func (s *RemoteBash) ExecShellLogging(shellName string, args ...string) error {
-	userHome, err := s.GetUserHome()
-	if err != nil {
-		return err
-	}
-	execShellPath := filepath.Join(userHome, s.shellDir, shellName)
-	localShellPath := filepath.Join(s.shellDir, shellName)
-	_, err = s.Run(fmt.Sprintf("mkdir -p %s", filepath.Join(userHome, s.shellDir)))
+	execShellPath, err := s.uploadShell(shellName)
 	if err != nil {
 		return err
 	}
-	shellExists, err := s.Run("test -f " + execShellPath + " && echo 1 || echo 0")
-	if err != nil {
-		return err
-	}
-	if strings.TrimSpace(shellExists) == "0" {
-		s.log.Info(fmt.Sprintf("shell %s not exists, copy from %s", execShellPath, localShellPath))
-		if err := s.SftpFile(localShellPath, execShellPath); err != nil {
-			return err
-		}
-	}
 	return s.RunWithLogging(fmt.Sprintf("sudo bash %s", execShellPath), args...)
 }
 
 func (s *RemoteBash) ExecShell(shellName string, args ...string) (stdout string, err error) {
+	execShellPath, err := s.uploadShell(shellName)
+	if err != nil {
+		return "", err
+	}
+	return s.Run(fmt.Sprintf("sudo bash %s", execShellPath), args...)
+}
+
+// uploadShell copies the shell to the server when it is missing or its checksum differs from the local one, it is
+// written to a temporary file and moved into place so connections running in parallel never execute a half written shell
+func (s *RemoteBash) uploadShell(shellName string) (string, error) {
 	userHome, err := s.GetUserHome()
 	if err != nil {
 		return "", err
@@ -225,17 +499,26 @@ func (s *RemoteBash) ExecShell(shellName string, args ...string) (stdout string,
 	if err != nil {
 		return "", err
 	}
-	shellExists, err := s.Run("test -f " + execShellPath + " && echo 1 || echo 0")
+	localShell, err := os.ReadFile(localShellPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "read shell %s", localShellPath)
+	}
+	remoteChecksum, err := s.Run(fmt.Sprintf("sha256sum %s 2>/dev/null | cut -d' ' -f1", execShellPath))
 	if err != nil {
 		return "", err
 	}
-	if strings.TrimSpace(shellExists) == "0" {
-		s.log.Info(fmt.Sprintf("shell %s not exists, copy from %s", execShellPath, localShellPath))
-		if err := s.SftpFile(localShellPath, execShellPath); err != nil {
+	if strings.TrimSpace(remoteChecksum) != fmt.Sprintf("%x", sha256.Sum256(localShell)) {
+		s.log.Info(fmt.Sprintf("shell %s is missing or changed, copy from %s", execShellPath, localShellPath))
+		tmpShellPath := fmt.Sprintf("%s.%d.tmp", execShellPath, time.Now().UnixNano())
+		if err := s.SftpFile(localShellPath, tmpShellPath); err != nil {
+			return "", err
+		}
+		_, err = s.Run(fmt.Sprintf("mv -f %s %s", tmpShellPath, execShellPath))
+		if err != nil {
 			return "", err
 		}
 	}
-	return s.Run(fmt.Sprintf("sudo bash %s", execShellPath), args...)
+	return execShellPath, nil
 }
 
 func (s *RemoteBash) SftpDirectory(localDir, remoteDir string) error {